@@ -1,41 +1,46 @@
 package main
 
 import (
+	"fmt"
 	"log"
-	"net/http"
+	"log/slog"
+	"os"
 	"tender_management_api/internal/config"
-	"tender_management_api/internal/database"
-	"tender_management_api/internal/middlewares"
-	"tender_management_api/internal/routers"
-
-	"github.com/gin-gonic/gin"
 )
 
 func main() {
 	cfg, err := config.LoadConfig()
+
+	// --print-config выводит действующую конфигурацию со скрытыми секретами и завершает работу.
+	// Конфигурация выводится и при ошибках в значениях, чтобы было видно, что именно прочитано
+	if len(os.Args) > 1 && os.Args[1] == "--print-config" {
+		if cfg != nil {
+			cfg.Print(os.Stdout)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Ошибки конфигурации:", err)
+			os.Exit(1)
+		}
+		return
+	}
 	if err != nil {
 		log.Fatal("Не удалось загрузить конфигурацию: ", err)
 	}
 
-	database.ConnectDatabase(cfg)
-
-	router := gin.Default()
-
-	// Маршрут для проверки доступности сервера
-	router.GET("/api/ping", func(c *gin.Context) {
-		c.String(http.StatusOK, "ok")
-	})
-
-	// Группа маршрутов с префиксом /api и middleware для аутентификации
-	api := router.Group("/api")
-	api.Use(middlewares.AuthMiddleware())
+	// Журнал в JSON; сообщения пакета log тоже попадают в него
+	slog.SetDefault(slog.New(slog.NewJSONHandler(os.Stderr, &slog.HandlerOptions{Level: cfg.LogLevel})))
+	for _, warning := range cfg.Warnings() {
+		slog.Warn(warning)
+	}
 
-	// Инициализация маршрутов
-	routers.InitTenderRoutes(api)
-	routers.InitBidRoutes(api)
+	// Подкоманда migrate управляет схемой базы данных и не запускает сервер
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(cfg, os.Args[2:])
+		return
+	}
 
-	// Запуск сервера
-	if err := router.Run(cfg.ServerAddress); err != nil {
-		log.Fatal("Не удалось запустить сервер: ", err)
+	if err := serve(cfg); err != nil {
+		slog.Error("Сервер завершил работу с ошибкой", "error", err)
+		os.Exit(1)
 	}
 }
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"tender_management_api/internal/config"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/models"
+	"time"
+)
+
+const migrateUsage = "Использование: migrate up | migrate down [N] | migrate status"
+
+// runMigrate выполняет подкоманду migrate: применение, откат или просмотр состояния миграций.
+func runMigrate(cfg *config.Config, args []string) {
+	if len(args) == 0 {
+		log.Fatal(migrateUsage)
+	}
+
+	db, err := database.OpenWithRetry(context.Background(), cfg)
+	if err != nil {
+		log.Fatal("Не удалось подключиться к базе данных: ", err)
+	}
+	migrations, err := database.Migrations(models.CurrentTables())
+	if err != nil {
+		log.Fatal("Не удалось загрузить миграции: ", err)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := database.MigrateUp(db, migrations)
+		for _, migration := range applied {
+			fmt.Printf("Применена миграция %d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal("Не удалось выполнить миграции: ", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("Новых миграций нет")
+		}
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil || steps < 1 {
+				log.Fatal("Количество откатываемых миграций должно быть положительным числом")
+			}
+		}
+		reverted, err := database.MigrateDown(db, migrations, steps)
+		for _, migration := range reverted {
+			fmt.Printf("Откачена миграция %d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatal("Не удалось откатить миграции: ", err)
+		}
+		if len(reverted) == 0 {
+			fmt.Println("Применённых миграций нет")
+		}
+	case "status":
+		statuses, err := database.MigrationStatuses(db, migrations)
+		if err != nil {
+			log.Fatal("Не удалось получить состояние миграций: ", err)
+		}
+		for _, status := range statuses {
+			state := "не применена"
+			if status.AppliedAt != nil {
+				state = "применена " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%d_%s\t%s\n", status.Version, status.Name, state)
+		}
+	default:
+		log.Fatal(migrateUsage)
+	}
+
+}
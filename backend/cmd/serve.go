@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"sync"
+	"syscall"
+	"tender_management_api/internal/config"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/health"
+	"tender_management_api/internal/metrics"
+	"tender_management_api/internal/middlewares"
+	"tender_management_api/internal/notifications"
+	"tender_management_api/internal/outbox"
+	"tender_management_api/internal/routers"
+	"tender_management_api/internal/tracing"
+	"tender_management_api/internal/webhooks"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// serve запускает сервер и фоновые обработчики и работает до SIGTERM или SIGINT.
+// При остановке сервис перестаёт быть готовым, но ещё cfg.ShutdownDrainDelay принимает запросы,
+// затем новые соединения не принимаются и за cfg.ShutdownTimeout завершаются начатые запросы.
+// После этого за cfg.WorkerShutdownTimeout останавливаются фоновые обработчики и закрывается
+// пул соединений с базой.
+func serve(cfg *config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Отправка накопленных спанов; сборщик может быть недоступен, поэтому время ограничено
+		flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(flushCtx); err != nil {
+			slog.Error("Не удалось отправить трассировки", "error", err)
+		}
+	}()
+
+	// Проверка запросов по спецификации OpenAPI
+	var spec *openapi3.T
+	if cfg.OpenAPISpecPath != "" {
+		spec, err = middlewares.LoadOpenAPISpec(cfg.OpenAPISpecPath)
+		if err != nil {
+			return fmt.Errorf("не удалось загрузить спецификацию OpenAPI: %w", err)
+		}
+	}
+
+	if err := database.ConnectDatabase(ctx, cfg); err != nil {
+		return err
+	}
+	defer closeDatabase()
+
+	// Фоновые обработчики получают свой контекст: они останавливаются только после того,
+	// как сервер завершит начатые запросы, события которых им ещё предстоит опубликовать
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	var workers sync.WaitGroup
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workersCtx)
+		}()
+	}
+
+	// Готовность складывается из доступности базы, версии схемы и работы фоновых обработчиков.
+	// Обработчик вебхуков может долго отправлять пачку доставок, поэтому его порог больше.
+	health.Default.Register(health.ComponentDatabase, 0)
+	health.Default.Register(health.ComponentMigrations, 0)
+	health.Default.Register(health.ComponentOutbox, time.Minute)
+	health.Default.Register(health.ComponentWebhooks, 5*time.Minute)
+	runWorker(func(ctx context.Context) {
+		health.PingDatabase(ctx, database.DB, health.Default, cfg.DBPingInterval)
+	})
+
+	// Публикация событий из outbox: вебхуки, лог, внутренняя шина, уведомления и письма
+	sinks := []outbox.Sink{
+		webhooks.Sink{DB: database.DB},
+		outbox.LogSink{},
+		outbox.BusSink{Bus: events.DefaultBus},
+		notifications.InboxSink{DB: database.DB},
+	}
+	if cfg.SMTPAddress != "" {
+		sinks = append(sinks, notifications.EmailSink{
+			DB: database.DB,
+			Sender: notifications.SMTPSender{
+				Address:  cfg.SMTPAddress,
+				Username: cfg.SMTPUsername,
+				Password: cfg.SMTPPassword,
+				From:     cfg.SMTPFrom,
+				Timeout:  cfg.SMTPTimeout,
+			},
+		})
+	}
+	runWorker(outbox.NewDispatcher(database.DB, sinks...).Run)
+	runWorker(webhooks.NewWorker(database.DB).Run)
+	runWorker(func(ctx context.Context) {
+		metrics.RefreshTendersByStatus(ctx, database.DB)
+	})
+
+	server := &http.Server{
+		Addr:         cfg.ServerAddress,
+		Handler:      routers.NewRouter(cfg, spec),
+		ReadTimeout:  cfg.ServerReadTimeout,
+		WriteTimeout: cfg.ServerWriteTimeout,
+		IdleTimeout:  cfg.ServerIdleTimeout,
+	}
+	// Потоки событий тендеров держат соединение открытым: закрытие шины завершает их
+	server.RegisterOnShutdown(events.DefaultBus.Close)
+
+	serverErr := make(chan error, 1)
+	go func() {
+		slog.Info("Сервер слушает", "address", cfg.ServerAddress)
+		serverErr <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("не удалось запустить сервер: %w", err)
+	case <-ctx.Done():
+	}
+	// Повторный сигнал завершает процесс сразу, не дожидаясь остановки
+	stop()
+
+	// Сервер ещё принимает запросы, пока балансировщик не заметит по /readyz, что экземпляр
+	// выводится из ротации
+	slog.Info("Получен сигнал остановки, вывод из ротации", "drainDelay", cfg.ShutdownDrainDelay.String())
+	health.Default.StartShutdown()
+	time.Sleep(cfg.ShutdownDrainDelay)
+
+	slog.Info("Завершение начатых запросов", "timeout", cfg.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	var shutdownErr error
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		shutdownErr = fmt.Errorf("не все запросы завершились до истечения срока остановки: %w", err)
+		server.Close()
+	}
+	if err := <-serverErr; !errors.Is(err, http.ErrServerClosed) {
+		shutdownErr = errors.Join(shutdownErr, err)
+	}
+
+	// У фоновых обработчиков свой срок: запросы могли израсходовать весь cfg.ShutdownTimeout
+	slog.Info("Остановка фоновых обработчиков", "timeout", cfg.WorkerShutdownTimeout.String())
+	stopWorkers()
+	stopped := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(cfg.WorkerShutdownTimeout):
+		shutdownErr = errors.Join(shutdownErr, errors.New("фоновые обработчики не остановились до истечения срока остановки"))
+	}
+
+	slog.Info("Сервер остановлен")
+	return shutdownErr
+}
+
+// closeDatabase закрывает пул соединений с базой данных.
+func closeDatabase() {
+	sqlDB, err := database.DB.DB()
+	if err != nil {
+		return
+	}
+	if err := sqlDB.Close(); err != nil {
+		slog.Error("Ошибка закрытия соединений с базой данных", "error", err)
+	}
+}
@@ -0,0 +1,208 @@
+// Package apierrors описывает каталог ошибок API со стабильными машиночитаемыми кодами
+// и их представление в формате RFC 7807 (application/problem+json).
+package apierrors
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentType — тип содержимого ответа с ошибкой.
+const ContentType = "application/problem+json"
+
+// typePrefix — префикс URI типа проблемы; к нему добавляется код ошибки.
+const typePrefix = "urn:tender-management:error:"
+
+// Code — стабильный машиночитаемый код ошибки. Коды не меняются между версиями API.
+type Code string
+
+const (
+	CodeValidationFailed        Code = "VALIDATION_FAILED"
+	CodeMissingParameter        Code = "MISSING_PARAMETER"
+	CodeInvalidParameter        Code = "INVALID_PARAMETER"
+	CodeInvalidTenderStatus     Code = "INVALID_TENDER_STATUS"
+	CodeInvalidBidStatus        Code = "INVALID_BID_STATUS"
+	CodeInvalidDecision         Code = "INVALID_DECISION"
+	CodeInvalidVersion          Code = "INVALID_VERSION"
+	CodeUnknownEventType        Code = "UNKNOWN_EVENT_TYPE"
+	CodeTokenMissing            Code = "TOKEN_MISSING"
+	CodeTokenMalformed          Code = "TOKEN_MALFORMED"
+	CodeTokenInvalid            Code = "TOKEN_INVALID"
+	CodeUserNotFound            Code = "USER_NOT_FOUND"
+	CodeRequesterNotFound       Code = "REQUESTER_NOT_FOUND"
+	CodeAuthorNotFound          Code = "AUTHOR_NOT_FOUND"
+	CodeForbiddenNotResponsible Code = "FORBIDDEN_NOT_RESPONSIBLE"
+	CodeConflictOfInterest      Code = "FORBIDDEN_CONFLICT_OF_INTEREST"
+	CodeRecusalRequired         Code = "FORBIDDEN_RECUSAL_REQUIRED"
+	CodeTenderNotFound          Code = "TENDER_NOT_FOUND"
+	CodeBidNotFound             Code = "BID_NOT_FOUND"
+	CodeVersionNotFound         Code = "VERSION_NOT_FOUND"
+	CodeNotificationNotFound    Code = "NOTIFICATION_NOT_FOUND"
+	CodeWebhookNotFound         Code = "WEBHOOK_NOT_FOUND"
+	CodeDeliveryNotFound        Code = "DELIVERY_NOT_FOUND"
+	CodeInternal                Code = "INTERNAL_ERROR"
+)
+
+// Error — ошибка API: код, HTTP-статус, заголовок и необязательное уточнение для клиента.
+// Тексты хранятся ключами каталога i18n и переводятся на язык запроса при отправке ответа.
+type Error struct {
+	Code     Code
+	Status   int
+	TitleKey i18n.Key
+	Detail   *i18n.Message
+
+	// cause — ошибка разбора или валидации тела запроса, уточнение строится из неё.
+	cause error
+}
+
+func (e *Error) Error() string {
+	return string(e.Code) + ": " + e.Reason(i18n.DefaultLanguage)
+}
+
+// WithMessage возвращает копию ошибки с уточнением из каталога сообщений.
+func (e *Error) WithMessage(key i18n.Key, args ...interface{}) *Error {
+	copied := *e
+	copied.Detail = &i18n.Message{Key: key, Args: args}
+	return &copied
+}
+
+// Title возвращает заголовок ошибки на заданном языке.
+func (e *Error) Title(lang string) string {
+	return i18n.T(lang, e.TitleKey)
+}
+
+// DetailText возвращает уточнение на заданном языке или пустую строку.
+func (e *Error) DetailText(lang string) string {
+	switch {
+	case e.cause != nil:
+		return i18n.ValidationMessage(lang, e.cause)
+	case e.Detail != nil:
+		return e.Detail.In(lang)
+	default:
+		return ""
+	}
+}
+
+// Reason — человекочитаемое описание для поля reason из контракта OpenAPI.
+func (e *Error) Reason(lang string) string {
+	if detail := e.DetailText(lang); detail != "" {
+		return detail
+	}
+	return e.Title(lang)
+}
+
+func newError(code Code, status int, titleKey i18n.Key) *Error {
+	return &Error{Code: code, Status: status, TitleKey: titleKey}
+}
+
+// Каталог ошибок.
+var (
+	ErrValidationFailed        = newError(CodeValidationFailed, http.StatusBadRequest, i18n.ErrorValidationFailed)
+	ErrMissingParameter        = newError(CodeMissingParameter, http.StatusBadRequest, i18n.ErrorMissingParameter)
+	ErrInvalidParameter        = newError(CodeInvalidParameter, http.StatusBadRequest, i18n.ErrorInvalidParameter)
+	ErrInvalidTenderStatus     = newError(CodeInvalidTenderStatus, http.StatusBadRequest, i18n.ErrorInvalidTenderStatus)
+	ErrInvalidBidStatus        = newError(CodeInvalidBidStatus, http.StatusBadRequest, i18n.ErrorInvalidBidStatus)
+	ErrInvalidDecision         = newError(CodeInvalidDecision, http.StatusBadRequest, i18n.ErrorInvalidDecision)
+	ErrInvalidVersion          = newError(CodeInvalidVersion, http.StatusBadRequest, i18n.ErrorInvalidVersion)
+	ErrUnknownEventType        = newError(CodeUnknownEventType, http.StatusBadRequest, i18n.ErrorUnknownEventType)
+	ErrTokenMissing            = newError(CodeTokenMissing, http.StatusUnauthorized, i18n.ErrorTokenMissing)
+	ErrTokenMalformed          = newError(CodeTokenMalformed, http.StatusUnauthorized, i18n.ErrorTokenMalformed)
+	ErrTokenInvalid            = newError(CodeTokenInvalid, http.StatusUnauthorized, i18n.ErrorTokenInvalid)
+	ErrUserNotFound            = newError(CodeUserNotFound, http.StatusUnauthorized, i18n.ErrorUserNotFound)
+	ErrRequesterNotFound       = newError(CodeRequesterNotFound, http.StatusUnauthorized, i18n.ErrorRequesterNotFound)
+	ErrAuthorNotFound          = newError(CodeAuthorNotFound, http.StatusUnauthorized, i18n.ErrorAuthorNotFound)
+	ErrForbiddenNotResponsible = newError(CodeForbiddenNotResponsible, http.StatusForbidden, i18n.ErrorForbiddenNotResponsible)
+	ErrConflictOfInterest      = newError(CodeConflictOfInterest, http.StatusForbidden, i18n.ErrorConflictOfInterest)
+	ErrRecusalRequired         = newError(CodeRecusalRequired, http.StatusForbidden, i18n.ErrorRecusalRequired)
+	ErrTenderNotFound          = newError(CodeTenderNotFound, http.StatusNotFound, i18n.ErrorTenderNotFound)
+	ErrBidNotFound             = newError(CodeBidNotFound, http.StatusNotFound, i18n.ErrorBidNotFound)
+	ErrVersionNotFound         = newError(CodeVersionNotFound, http.StatusNotFound, i18n.ErrorVersionNotFound)
+	ErrNotificationNotFound    = newError(CodeNotificationNotFound, http.StatusNotFound, i18n.ErrorNotificationNotFound)
+	ErrWebhookNotFound         = newError(CodeWebhookNotFound, http.StatusNotFound, i18n.ErrorWebhookNotFound)
+	ErrDeliveryNotFound        = newError(CodeDeliveryNotFound, http.StatusNotFound, i18n.ErrorDeliveryNotFound)
+	ErrInternal                = newError(CodeInternal, http.StatusInternalServerError, i18n.ErrorInternal)
+)
+
+// MissingParameter возвращает ошибку об отсутствии одного или двух обязательных параметров.
+func MissingParameter(names ...string) *Error {
+	if len(names) == 2 {
+		return ErrMissingParameter.WithMessage(i18n.MsgParametersRequired, names[0], names[1])
+	}
+	return ErrMissingParameter.WithMessage(i18n.MsgParameterRequired, strings.Join(names, ", "))
+}
+
+// InvalidParameter возвращает ошибку о некорректном значении параметра.
+func InvalidParameter(key i18n.Key, args ...interface{}) *Error {
+	return ErrInvalidParameter.WithMessage(key, args...)
+}
+
+// Validation оборачивает ошибку разбора или валидации тела запроса.
+func Validation(err error) *Error {
+	copied := *ErrValidationFailed
+	copied.cause = err
+	return &copied
+}
+
+// Problem — тело ответа с ошибкой по RFC 7807. Поле reason сохраняется для совместимости с контрактом OpenAPI.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Code     Code   `json:"code"`
+	Reason   string `json:"reason"`
+	// RequestID совпадает с заголовком X-Request-ID и записью в журнале запросов.
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// NewProblem формирует тело ответа для ошибки API на заданном языке.
+func NewProblem(e *Error, lang, instance string) Problem {
+	return Problem{
+		Type:     typePrefix + string(e.Code),
+		Title:    e.Title(lang),
+		Status:   e.Status,
+		Detail:   e.DetailText(lang),
+		Instance: instance,
+		Code:     e.Code,
+		Reason:   e.Reason(lang),
+	}
+}
+
+// Respond отправляет ошибку клиенту на языке из Accept-Language и прерывает обработку запроса.
+// Ошибки вне каталога (например, ошибки базы данных) записываются в журнал,
+// а клиент получает INTERNAL_ERROR без подробностей.
+func Respond(c *gin.Context, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		slog.ErrorContext(c.Request.Context(), "Внутренняя ошибка при обработке запроса",
+			"request_id", requestid.FromContext(c),
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"error", err)
+		apiErr = ErrInternal
+	}
+	c.Set(codeContextKey, apiErr.Code)
+
+	lang := i18n.FromContext(c)
+	problem := NewProblem(apiErr, lang, c.Request.URL.Path)
+	problem.RequestID = requestid.FromContext(c)
+	c.Header("Content-Type", ContentType)
+	c.Header(i18n.ContentLanguageHeader, lang)
+	c.AbortWithStatusJSON(apiErr.Status, problem)
+}
+
+const codeContextKey = "apierrors.code"
+
+// CodeFromContext возвращает код ошибки, с которой завершился запрос, или пустую строку.
+func CodeFromContext(c *gin.Context) Code {
+	code, _ := c.Get(codeContextKey)
+	value, _ := code.(Code)
+	return value
+}
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"encoding/json"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// TenderEvent подготавливает событие аудита для тендера.
+func TenderEvent(actor models.User, tender models.Tender, action models.AuditAction) models.AuditEvent {
+	return models.AuditEvent{
+		ActorID:        actor.ID,
+		ActorUsername:  actor.Username,
+		EntityType:     models.AuditEntityTender,
+		EntityID:       tender.ID,
+		TenderID:       tender.ID,
+		OrganizationID: &tender.OrganizationID,
+		Action:         action,
+	}
+}
+
+// BidEvent подготавливает событие аудита для предложения.
+// Событие относится к организации-автору предложения, а также видно ответственным за тендер.
+// У предложения от имени пользователя организации нет, и OrganizationID остаётся пустым.
+func BidEvent(actor models.User, bid models.Bid, action models.AuditAction) models.AuditEvent {
+	event := models.AuditEvent{
+		ActorID:       actor.ID,
+		ActorUsername: actor.Username,
+		EntityType:    models.AuditEntityBid,
+		EntityID:      bid.ID,
+		TenderID:      bid.TenderID,
+		Action:        action,
+	}
+	if bid.AuthorType == models.BidAuthorTypeOrganization {
+		organizationID := bid.AuthorID
+		event.OrganizationID = &organizationID
+	}
+	return event
+}
+
+// Record сохраняет событие аудита с состояниями сущности до и после изменения
+// и добавляет его в цепочку событий тендера. Вызывается внутри той же транзакции, что и само изменение.
+func Record(tx *gorm.DB, c *gin.Context, event models.AuditEvent, before, after interface{}) error {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return err
+	}
+
+	event.Before = models.JSONText(beforeJSON)
+	event.After = models.JSONText(afterJSON)
+	event.RequestID = requestid.FromContext(c)
+
+	return tx.Transaction(func(tx *gorm.DB) error {
+		if err := appendToChain(tx, &event); err != nil {
+			return err
+		}
+		return tx.Create(&event).Error
+	})
+}
@@ -0,0 +1,203 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BrokenLink описывает первое найденное нарушение цепочки.
+// Reason заполняется на языке по умолчанию; ReasonKey позволяет перевести причину на язык запроса.
+type BrokenLink struct {
+	Sequence  int64     `json:"sequence"`
+	EventID   uuid.UUID `json:"eventId"`
+	Reason    string    `json:"reason"`
+	ReasonKey i18n.Key  `json:"-"`
+}
+
+// ChainReport — результат проверки цепочки событий тендера.
+// HeadSequence и HeadHash описывают вершину цепочки: клиент может сохранить их и при следующей
+// проверке убедиться, что цепочка не стала короче, даже если вершину изменили вместе с записями.
+type ChainReport struct {
+	TenderID      uuid.UUID   `json:"tenderId"`
+	Valid         bool        `json:"valid"`
+	CheckedEvents int         `json:"checkedEvents"`
+	HeadSequence  int64       `json:"headSequence,omitempty"`
+	HeadHash      string      `json:"headHash,omitempty"`
+	BrokenLink    *BrokenLink `json:"brokenLink,omitempty"`
+}
+
+// chainContent — содержимое записи, от которого считается хеш. Порядок полей фиксирован.
+// Пустой OrganizationID даёт null, а заполненный сериализуется строкой, как и до того, как поле стало необязательным.
+type chainContent struct {
+	ID             uuid.UUID  `json:"id"`
+	PrevHash       string     `json:"prevHash"`
+	Sequence       int64      `json:"sequence"`
+	TenderID       uuid.UUID  `json:"tenderId"`
+	OrganizationID *uuid.UUID `json:"organizationId"`
+	EntityType     string     `json:"entityType"`
+	EntityID       uuid.UUID  `json:"entityId"`
+	Action         string     `json:"action"`
+	ActorID        uuid.UUID  `json:"actorId"`
+	ActorUsername  string     `json:"actorUsername"`
+	Before         string     `json:"before"`
+	After          string     `json:"after"`
+	RequestID      string     `json:"requestId"`
+	CreatedAt      string     `json:"createdAt"`
+}
+
+// ContentHash вычисляет SHA-256 от содержимого записи, включая хеш предыдущей записи.
+func ContentHash(event models.AuditEvent) (string, error) {
+	before, err := canonicalJSON(event.Before)
+	if err != nil {
+		return "", err
+	}
+	after, err := canonicalJSON(event.After)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := json.Marshal(chainContent{
+		ID:             event.ID,
+		PrevHash:       event.PrevHash,
+		Sequence:       event.Sequence,
+		TenderID:       event.TenderID,
+		OrganizationID: event.OrganizationID,
+		EntityType:     string(event.EntityType),
+		EntityID:       event.EntityID,
+		Action:         string(event.Action),
+		ActorID:        event.ActorID,
+		ActorUsername:  event.ActorUsername,
+		Before:         before,
+		After:          after,
+		RequestID:      event.RequestID,
+		CreatedAt:      event.CreatedAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// canonicalJSON приводит JSON к каноническому виду: Postgres хранит jsonb
+// в нормализованной форме, поэтому хеш считается от переупорядоченного документа.
+func canonicalJSON(text models.JSONText) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(text)))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", err
+	}
+
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(canonical), nil
+}
+
+// appendToChain присваивает событию номер в цепочке тендера, вычисляет его хеш и переносит вершину цепочки.
+// Блокировка на уровне транзакции не даёт двум записям получить один номер.
+func appendToChain(tx *gorm.DB, event *models.AuditEvent) error {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", event.TenderID.String()).Error; err != nil {
+		return err
+	}
+
+	var last models.AuditEvent
+	if err := tx.Where("tender_id = ?", event.TenderID).Order("sequence DESC").Limit(1).Find(&last).Error; err != nil {
+		return err
+	}
+
+	event.ID = uuid.New()
+	event.Sequence = last.Sequence + 1
+	event.PrevHash = last.Hash
+	// Postgres хранит время с точностью до микросекунд
+	event.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
+
+	hash, err := ContentHash(*event)
+	if err != nil {
+		return err
+	}
+	event.Hash = hash
+
+	head := models.AuditChainHead{TenderID: event.TenderID, Sequence: event.Sequence, Hash: event.Hash, UpdatedAt: event.CreatedAt}
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "tender_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"sequence", "hash", "updated_at"}),
+	}).Create(&head).Error
+}
+
+// VerifyChain проходит цепочку событий тендера и сообщает о первом нарушенном звене.
+// Последняя запись сверяется с сохранённой вершиной цепочки, поэтому удаление записей с конца тоже обнаруживается.
+func VerifyChain(db *gorm.DB, tenderID uuid.UUID) (ChainReport, error) {
+	report := ChainReport{TenderID: tenderID, Valid: true}
+
+	var events []models.AuditEvent
+	if err := db.Where("tender_id = ?", tenderID).Order("sequence ASC").Find(&events).Error; err != nil {
+		return report, err
+	}
+	var heads []models.AuditChainHead
+	if err := db.Where("tender_id = ?", tenderID).Limit(1).Find(&heads).Error; err != nil {
+		return report, err
+	}
+
+	prevHash := ""
+	for i, event := range events {
+		var reason i18n.Key
+		hash, err := ContentHash(event)
+		switch {
+		case event.Sequence != int64(i+1):
+			reason = i18n.MsgChainSequenceBroken
+		case event.PrevHash != prevHash:
+			reason = i18n.MsgChainPrevHashBroken
+		case err != nil:
+			reason = i18n.MsgChainUnreadable
+		case hash != event.Hash:
+			reason = i18n.MsgChainHashMismatch
+		}
+
+		report.CheckedEvents++
+		if reason != "" {
+			report.Valid = false
+			report.BrokenLink = &BrokenLink{
+				Sequence:  event.Sequence,
+				EventID:   event.ID,
+				Reason:    i18n.T(i18n.DefaultLanguage, reason),
+				ReasonKey: reason,
+			}
+			return report, nil
+		}
+
+		prevHash = event.Hash
+	}
+
+	report.HeadSequence = int64(len(events))
+	report.HeadHash = prevHash
+	if len(heads) > 0 && (heads[0].Sequence != report.HeadSequence || heads[0].Hash != report.HeadHash) {
+		report.Valid = false
+		report.BrokenLink = &BrokenLink{
+			Sequence:  heads[0].Sequence,
+			Reason:    i18n.T(i18n.DefaultLanguage, i18n.MsgChainHeadMismatch),
+			ReasonKey: i18n.MsgChainHeadMismatch,
+		}
+		if len(events) > 0 {
+			report.BrokenLink.EventID = events[len(events)-1].ID
+		}
+	}
+	return report, nil
+}
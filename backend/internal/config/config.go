@@ -1,26 +1,383 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"github.com/joho/godotenv"
+	"io"
+	"io/fs"
+	"log/slog"
+	"net"
+	"net/url"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type Config struct {
 	ServerAddress string
 	PostgresConn  string
+
+	// Таймауты HTTP-сервера и время на завершение обработки запросов при остановке.
+	ServerReadTimeout  time.Duration
+	ServerWriteTimeout time.Duration
+	ServerIdleTimeout  time.Duration
+	ShutdownTimeout    time.Duration
+	// Сколько сервер продолжает принимать запросы после того, как /readyz начал отвечать 503,
+	// чтобы балансировщик успел убрать экземпляр из ротации.
+	ShutdownDrainDelay time.Duration
+	// Время на остановку фоновых обработчиков после завершения запросов.
+	WorkerShutdownTimeout time.Duration
+
+	// Пул соединений с PostgreSQL.
+	DBMaxOpenConns    int
+	DBMaxIdleConns    int
+	DBConnMaxLifetime time.Duration
+	DBConnMaxIdleTime time.Duration
+	// Сколько ждать доступности PostgreSQL при запуске и как часто проверять соединение после.
+	DBStartupTimeout time.Duration
+	DBPingInterval   time.Duration
+
+	// Секрет для проверки подписи JWT.
+	JWTSecret string
+	// Минимальный уровень сообщений в логе.
+	LogLevel slog.Level
+
+	// Настройки SMTP для отправки писем. Если адрес не задан, письма не отправляются.
+	SMTPAddress  string
+	SMTPUsername string
+	SMTPPassword string
+	SMTPFrom     string
+	// Срок на отправку одного письма, включая подключение к серверу.
+	SMTPTimeout time.Duration
+
+	// Схема и таблицы с сотрудниками и организациями, которые уже существуют в базе.
+	// Пустая схема означает схему по умолчанию из search_path. Имена подставляются в миграции:
+	// недостающие таблицы создаются, а в таблицу сотрудников добавляются столбцы для уведомлений.
+	// Остальные нужные столбцы проверяются при запуске.
+	DatabaseSchema               string
+	EmployeeTable                string
+	OrganizationTable            string
+	OrganizationResponsibleTable string
+
+	// Путь к спецификации OpenAPI, по которой проверяются запросы. Пустое значение отключает проверку.
+	OpenAPISpecPath string
+	// Проверять ли также ответы. Нарушения только записываются в лог; режим предназначен для отладки.
+	OpenAPIValidateResponses bool
+
+	// Куда отправлять трассировки: none, stdout или otlp. Для otlp адрес сборщика берётся из
+	// OTLPEndpoint, а если он пуст — из стандартных переменных OTEL_EXPORTER_OTLP_*.
+	TracingExporter    string
+	TracingServiceName string
+	OTLPEndpoint       string
+
+	// settings — прочитанные переменные окружения в порядке чтения, для вывода конфигурации.
+	settings []setting
+	// warnings — допустимые, но небезопасные значения, о которых нужно предупредить при запуске.
+	warnings []string
 }
 
+// identifier — допустимое имя схемы или таблицы PostgreSQL без кавычек.
+var identifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// defaultOpenAPISpecPath — путь к спецификации при запуске из каталога backend.
+const defaultOpenAPISpecPath = "../задание/openapi.yml"
+
+// defaultJWTSecret — секрет, которым подписаны токены проверяющей системы. Он известен всем,
+// поэтому при запуске с ним выводится предупреждение.
+const defaultJWTSecret = "secret_key"
+
+// redacted заменяет значения секретов при выводе конфигурации.
+const redacted = "xxxxx"
+
+// LoadConfig читает конфигурацию из переменных окружения и файла .env, если он есть.
+// Все найденные ошибки возвращаются вместе. Если ошибки только в значениях переменных, вместе с ними
+// возвращается и конфигурация, в которой некорректные значения заменены значениями по умолчанию:
+// её можно вывести, чтобы разобраться в ошибке.
 func LoadConfig() (*Config, error) {
-	err := godotenv.Load()
-	if err != nil {
-		fmt.Println("Ошибка загрузки файла .env")
+	if err := godotenv.Load(); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("не удалось прочитать файл .env: %w", err)
 	}
 
+	env := &environment{}
 	config := &Config{
-		ServerAddress: os.Getenv("SERVER_ADDRESS"),
-		PostgresConn:  os.Getenv("POSTGRES_CONN"),
+		ServerAddress: env.string("SERVER_ADDRESS", "0.0.0.0:8080"),
+
+		ServerReadTimeout:     env.duration("SERVER_READ_TIMEOUT", 10*time.Second),
+		ServerWriteTimeout:    env.duration("SERVER_WRITE_TIMEOUT", 30*time.Second),
+		ServerIdleTimeout:     env.duration("SERVER_IDLE_TIMEOUT", 2*time.Minute),
+		ShutdownTimeout:       env.duration("SHUTDOWN_TIMEOUT", 20*time.Second),
+		ShutdownDrainDelay:    env.nonNegativeDuration("SHUTDOWN_DRAIN_DELAY", 5*time.Second),
+		WorkerShutdownTimeout: env.duration("WORKER_SHUTDOWN_TIMEOUT", 10*time.Second),
+
+		DBMaxOpenConns:    env.int("POSTGRES_MAX_OPEN_CONNS", 20),
+		DBMaxIdleConns:    env.int("POSTGRES_MAX_IDLE_CONNS", 10),
+		DBConnMaxLifetime: env.duration("POSTGRES_CONN_MAX_LIFETIME", 30*time.Minute),
+		DBConnMaxIdleTime: env.duration("POSTGRES_CONN_MAX_IDLE_TIME", 5*time.Minute),
+		DBStartupTimeout:  env.nonNegativeDuration("POSTGRES_STARTUP_TIMEOUT", time.Minute),
+		DBPingInterval:    env.duration("POSTGRES_PING_INTERVAL", 10*time.Second),
+
+		JWTSecret: env.secret("JWT_SECRET", defaultJWTSecret),
+		LogLevel:  env.level("LOG_LEVEL", slog.LevelInfo),
+
+		SMTPAddress:  env.string("SMTP_ADDRESS", ""),
+		SMTPUsername: env.string("SMTP_USERNAME", ""),
+		SMTPPassword: env.secret("SMTP_PASSWORD", ""),
+		SMTPFrom:     env.string("SMTP_FROM", ""),
+		SMTPTimeout:  env.duration("SMTP_TIMEOUT", 30*time.Second),
+
+		DatabaseSchema:               env.string("POSTGRES_SCHEMA", ""),
+		EmployeeTable:                env.string("POSTGRES_EMPLOYEE_TABLE", "employee"),
+		OrganizationTable:            env.string("POSTGRES_ORGANIZATION_TABLE", "organization"),
+		OrganizationResponsibleTable: env.string("POSTGRES_ORGANIZATION_RESPONSIBLE_TABLE", "organization_responsible"),
+
+		OpenAPISpecPath:          env.optional("OPENAPI_SPEC_PATH", defaultOpenAPISpecPath),
+		OpenAPIValidateResponses: env.bool("OPENAPI_VALIDATE_RESPONSES", false),
+
+		TracingExporter:    env.string("TRACING_EXPORTER", "none"),
+		TracingServiceName: env.string("OTEL_SERVICE_NAME", "tender-management-api"),
+		OTLPEndpoint:       env.string("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+	}
+	config.PostgresConn = env.postgresConn()
+
+	env.validate(config)
+	config.settings = env.settings
+	config.warnings = env.warnings
+	return config, errors.Join(env.errs...)
+}
+
+// Warnings возвращает предупреждения о небезопасных значениях, например о секрете JWT по умолчанию.
+func (c *Config) Warnings() []string {
+	return c.warnings
+}
+
+// Print выводит действующую конфигурацию в виде переменных окружения. Секреты и пароль
+// в строке подключения к PostgreSQL скрываются.
+func (c *Config) Print(w io.Writer) {
+	for _, s := range c.settings {
+		value := s.value
+		switch {
+		case s.secret && value != "":
+			value = redacted
+		case s.key == "POSTGRES_CONN" || s.key == "POSTGRES_JDBC_URL":
+			value = redactConn(value)
+		}
+		fmt.Fprintf(w, "%s=%s\n", s.key, value)
+	}
+}
+
+// setting — переменная окружения и её действующее значение.
+type setting struct {
+	key    string
+	value  string
+	secret bool
+}
+
+// environment читает переменные окружения, запоминает действующие значения и копит ошибки разбора.
+type environment struct {
+	settings []setting
+	errs     []error
+	warnings []string
+}
+
+func (e *environment) lookup(key, fallback string, secret bool) string {
+	value, ok := os.LookupEnv(key)
+	if !ok || value == "" {
+		value = fallback
+	}
+	e.settings = append(e.settings, setting{key: key, value: value, secret: secret})
+	return value
+}
+
+func (e *environment) string(key, fallback string) string {
+	return e.lookup(key, fallback, false)
+}
+
+func (e *environment) secret(key, fallback string) string {
+	return e.lookup(key, fallback, true)
+}
+
+// optional в отличие от string различает незаданную и пустую переменную: пустое значение отключает настройку.
+func (e *environment) optional(key, fallback string) string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		value = fallback
+	}
+	e.settings = append(e.settings, setting{key: key, value: value})
+	return value
+}
+
+func (e *environment) int(key string, fallback int) int {
+	raw := e.lookup(key, strconv.Itoa(fallback), false)
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		e.errs = append(e.errs, fmt.Errorf("%s: ожидалось неотрицательное целое число, получено %q", key, raw))
+		return fallback
+	}
+	return value
+}
+
+func (e *environment) duration(key string, fallback time.Duration) time.Duration {
+	raw := e.lookup(key, fallback.String(), false)
+	value, err := time.ParseDuration(raw)
+	if err != nil || value <= 0 {
+		e.errs = append(e.errs, fmt.Errorf("%s: ожидалась положительная длительность вида 30s или 5m, получено %q", key, raw))
+		return fallback
 	}
+	return value
+}
 
-	return config, nil
+// nonNegativeDuration в отличие от duration допускает ноль.
+func (e *environment) nonNegativeDuration(key string, fallback time.Duration) time.Duration {
+	raw := e.lookup(key, fallback.String(), false)
+	value, err := time.ParseDuration(raw)
+	if err != nil || value < 0 {
+		e.errs = append(e.errs, fmt.Errorf("%s: ожидалась неотрицательная длительность вида 0s, 30s или 5m, получено %q", key, raw))
+		return fallback
+	}
+	return value
+}
+
+func (e *environment) bool(key string, fallback bool) bool {
+	raw := e.lookup(key, strconv.FormatBool(fallback), false)
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: ожидалось true или false, получено %q", key, raw))
+		return fallback
+	}
+	return value
+}
+
+func (e *environment) level(key string, fallback slog.Level) slog.Level {
+	raw := e.lookup(key, strings.ToLower(fallback.String()), false)
+	var value slog.Level
+	if err := value.UnmarshalText([]byte(raw)); err != nil {
+		e.errs = append(e.errs, fmt.Errorf("%s: ожидалось debug, info, warn или error, получено %q", key, raw))
+		return fallback
+	}
+	return value
+}
+
+// postgresConn возвращает строку подключения к PostgreSQL. POSTGRES_CONN используется как есть;
+// иначе строка собирается из POSTGRES_JDBC_URL или POSTGRES_HOST/PORT/DATABASE
+// с учётными данными из POSTGRES_USERNAME и POSTGRES_PASSWORD.
+func (e *environment) postgresConn() string {
+	username := e.string("POSTGRES_USERNAME", "")
+	password := e.secret("POSTGRES_PASSWORD", "")
+
+	if conn, ok := os.LookupEnv("POSTGRES_CONN"); ok && conn != "" {
+		e.settings = append(e.settings, setting{key: "POSTGRES_CONN", value: conn})
+		return conn
+	}
+
+	host := e.string("POSTGRES_HOST", "")
+	port := e.string("POSTGRES_PORT", "5432")
+	database := e.string("POSTGRES_DATABASE", "")
+	query := url.Values{}
+
+	if jdbc := e.string("POSTGRES_JDBC_URL", ""); jdbc != "" {
+		parsed, err := url.Parse(strings.TrimPrefix(jdbc, "jdbc:"))
+		if err != nil || parsed.Scheme != "postgresql" || parsed.Hostname() == "" {
+			e.errs = append(e.errs, fmt.Errorf("POSTGRES_JDBC_URL: ожидалась строка вида jdbc:postgresql://host:port/dbname"))
+			return ""
+		}
+		host = parsed.Hostname()
+		if parsed.Port() != "" {
+			port = parsed.Port()
+		}
+		if name := strings.TrimPrefix(parsed.Path, "/"); name != "" {
+			database = name
+		}
+		// Учётные данные могут быть переданы параметрами JDBC-строки
+		params := parsed.Query()
+		if username == "" {
+			username = params.Get("user")
+		}
+		if password == "" {
+			password = params.Get("password")
+		}
+		if sslmode := params.Get("sslmode"); sslmode != "" {
+			query.Set("sslmode", sslmode)
+		}
+	}
+
+	if host == "" || database == "" {
+		e.errs = append(e.errs, errors.New("не задано подключение к PostgreSQL: укажите POSTGRES_CONN, POSTGRES_JDBC_URL или POSTGRES_HOST и POSTGRES_DATABASE"))
+		return ""
+	}
+
+	conn := url.URL{
+		Scheme:   "postgres",
+		Host:     net.JoinHostPort(host, port),
+		Path:     "/" + database,
+		RawQuery: query.Encode(),
+	}
+	if username != "" {
+		conn.User = url.UserPassword(username, password)
+	}
+	e.settings = append(e.settings, setting{key: "POSTGRES_CONN", value: conn.String()})
+	return conn.String()
+}
+
+// validate проверяет согласованность значений.
+func (e *environment) validate(config *Config) {
+	if _, _, err := net.SplitHostPort(config.ServerAddress); err != nil {
+		e.errs = append(e.errs, fmt.Errorf("SERVER_ADDRESS: ожидался адрес вида host:port, получено %q", config.ServerAddress))
+	}
+	if config.DBMaxOpenConns == 0 {
+		e.errs = append(e.errs, errors.New("POSTGRES_MAX_OPEN_CONNS: значение должно быть больше нуля"))
+	}
+	if config.DBMaxIdleConns > config.DBMaxOpenConns {
+		e.errs = append(e.errs, fmt.Errorf("POSTGRES_MAX_IDLE_CONNS: значение %d больше POSTGRES_MAX_OPEN_CONNS=%d",
+			config.DBMaxIdleConns, config.DBMaxOpenConns))
+	}
+	switch config.TracingExporter {
+	case "none", "stdout", "otlp":
+	default:
+		e.errs = append(e.errs, fmt.Errorf("TRACING_EXPORTER: ожидалось none, stdout или otlp, получено %q", config.TracingExporter))
+	}
+	if config.JWTSecret == defaultJWTSecret {
+		e.warnings = append(e.warnings, "JWT_SECRET совпадает с общеизвестным значением по умолчанию, "+
+			"и любой может выпустить действительный токен. Задайте JWT_SECRET в рабочем окружении")
+	}
+
+	names := map[string]string{
+		"POSTGRES_EMPLOYEE_TABLE":                 config.EmployeeTable,
+		"POSTGRES_ORGANIZATION_TABLE":             config.OrganizationTable,
+		"POSTGRES_ORGANIZATION_RESPONSIBLE_TABLE": config.OrganizationResponsibleTable,
+	}
+	if config.DatabaseSchema != "" {
+		names["POSTGRES_SCHEMA"] = config.DatabaseSchema
+	}
+	for key, name := range names {
+		if !identifier.MatchString(name) {
+			e.errs = append(e.errs, fmt.Errorf("%s: некорректное имя %q", key, name))
+		}
+	}
+}
+
+// keywordPassword находит пароль в строке подключения вида "host=... password=...".
+var keywordPassword = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// redactConn скрывает пароль в строке подключения к PostgreSQL.
+func redactConn(conn string) string {
+	if strings.Contains(conn, "://") {
+		parsed, err := url.Parse(strings.TrimPrefix(conn, "jdbc:"))
+		if err != nil {
+			return redacted
+		}
+		query := parsed.Query()
+		if query.Has("password") {
+			query.Set("password", redacted)
+			parsed.RawQuery = query.Encode()
+		}
+		result := parsed.Redacted()
+		if strings.HasPrefix(conn, "jdbc:") {
+			result = "jdbc:" + result
+		}
+		return result
+	}
+	return keywordPassword.ReplaceAllString(conn, "${1}"+redacted)
 }
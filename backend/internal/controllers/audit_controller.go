@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/audit"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/utils"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func GetAuditEvents(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	// Журнал доступен только ответственным за организации
+	var responsibleCount int64
+	if err := database.DB.WithContext(c.Request.Context()).Model(&models.OrganizationResponsible{}).Where("user_id = ?", user.ID).Count(&responsibleCount).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	if responsibleCount == 0 {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	userOrganizations := database.DB.WithContext(c.Request.Context()).Model(&models.OrganizationResponsible{}).
+		Select("organization_id").
+		Where("user_id = ?", user.ID)
+	userTenders := database.DB.WithContext(c.Request.Context()).Model(&models.Tender{}).
+		Select("id").
+		Where("organization_id IN (?)", userOrganizations)
+
+	userBids := database.DB.WithContext(c.Request.Context()).Model(&models.Bid{}).
+		Select("id").
+		Where("author_type = ? AND author_id = ?", models.BidAuthorTypeUser, user.ID)
+
+	// Видны события своих организаций, события по тендерам своих организаций
+	// и события предложений, поданных пользователем от своего имени
+	query := database.DB.WithContext(c.Request.Context()).Model(&models.AuditEvent{}).
+		Where("organization_id IN (?) OR tender_id IN (?) OR (entity_type = ? AND entity_id IN (?))",
+			userOrganizations, userTenders, models.AuditEntityBid, userBids)
+
+	// Фильтрация по идентификаторам
+	for param, column := range map[string]string{
+		"entityId":       "entity_id",
+		"tenderId":       "tender_id",
+		"organizationId": "organization_id",
+	} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		id, err := uuid.Parse(value)
+		if err != nil {
+			apierrors.Respond(c, apierrors.InvalidParameter(i18n.MsgInvalidParameter, param))
+			return
+		}
+		query = query.Where(column+" = ?", id)
+	}
+
+	if entityType := c.Query("entityType"); entityType != "" {
+		query = query.Where("entity_type = ?", entityType)
+	}
+	if action := c.Query("action"); action != "" {
+		query = query.Where("action = ?", action)
+	}
+	if actor := c.Query("actor"); actor != "" {
+		query = query.Where("actor_username = ?", actor)
+	}
+
+	// Фильтрация по периоду
+	for param, condition := range map[string]string{
+		"from": "created_at >= ?",
+		"to":   "created_at <= ?",
+	} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		moment, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			apierrors.Respond(c, apierrors.InvalidParameter(i18n.MsgInvalidTimeFormat, param))
+			return
+		}
+		query = query.Where(condition, moment)
+	}
+
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	var events []models.AuditEvent
+	if err := query.Limit(limit).Offset(offset).Order("created_at DESC").Find(&events).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	response := dto.NewAuditEvents(events)
+	if err := redactBidSnapshots(c.Request.Context(), user.ID, response); err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// bidSnapshotActions — действия, у которых Before и After содержат само предложение.
+// Отзыв и находка о конфликте интересов текста предложения не раскрывают.
+var bidSnapshotActions = map[models.AuditAction]bool{
+	models.AuditActionCreate:       true,
+	models.AuditActionEdit:         true,
+	models.AuditActionStatusChange: true,
+	models.AuditActionRollback:     true,
+	models.AuditActionDecision:     true,
+}
+
+// redactBidSnapshots скрывает содержимое предложений, автора которых пользователь не представляет.
+// Ответственный за тендер видит, что с предложениями конкурентов происходило, но не их текст.
+func redactBidSnapshots(ctx context.Context, userID uuid.UUID, events []dto.AuditEvent) error {
+	var bidIDs []string
+	for _, event := range events {
+		if event.EntityType == models.AuditEntityBid && bidSnapshotActions[event.Action] {
+			bidIDs = append(bidIDs, event.EntityID)
+		}
+	}
+	if len(bidIDs) == 0 {
+		return nil
+	}
+
+	var bids []models.Bid
+	if err := database.DB.WithContext(ctx).Select("id", "author_type", "author_id").Where("id IN ?", bidIDs).Find(&bids).Error; err != nil {
+		return err
+	}
+	var organizationIDs []uuid.UUID
+	if err := database.DB.WithContext(ctx).Model(&models.OrganizationResponsible{}).Where("user_id = ?", userID).Pluck("organization_id", &organizationIDs).Error; err != nil {
+		return err
+	}
+	userOrganizations := make(map[uuid.UUID]bool, len(organizationIDs))
+	for _, id := range organizationIDs {
+		userOrganizations[id] = true
+	}
+
+	represented := make(map[string]bool, len(bids))
+	for _, bid := range bids {
+		if bid.AuthorType == models.BidAuthorTypeUser {
+			represented[bid.ID.String()] = bid.AuthorID == userID
+		} else {
+			represented[bid.ID.String()] = userOrganizations[bid.AuthorID]
+		}
+	}
+
+	for i := range events {
+		event := &events[i]
+		if event.EntityType != models.AuditEntityBid || !bidSnapshotActions[event.Action] || represented[event.EntityID] {
+			continue
+		}
+		event.Before = ""
+		event.After = ""
+		event.Redacted = true
+	}
+	return nil
+}
+
+func VerifyTenderAuditChain(c *gin.Context) {
+	tenderID := c.Param("tenderId")
+	username := c.Query("username")
+
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	// Проверка существования тендера
+	var tender models.Tender
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
+		return
+	}
+
+	// Проверка прав доступа
+	var orgResp models.OrganizationResponsible
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	report, err := audit.VerifyChain(database.DB.WithContext(c.Request.Context()), tender.ID)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	if report.BrokenLink != nil {
+		report.BrokenLink.Reason = i18n.T(i18n.FromContext(c), report.BrokenLink.ReasonKey)
+	}
+
+	c.JSON(http.StatusOK, report)
+}
@@ -3,12 +3,19 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/audit"
 	"tender_management_api/internal/database"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/metrics"
 	"tender_management_api/internal/models"
+	"tender_management_api/internal/outbox"
 	"tender_management_api/internal/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type CreateBidInput struct {
@@ -23,28 +30,43 @@ type CreateBidInput struct {
 func CreateBid(c *gin.Context) {
 	var input CreateBidInput
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": err.Error()})
+		apierrors.Respond(c, apierrors.Validation(err))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", input.CreatorUsername).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", input.CreatorUsername).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", input.TenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", input.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка, является ли пользователь ответственным за организацию
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", input.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", input.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	// Проверка конфликта интересов: организации тендера и предложения не должны иметь общих ответственных
+	conflict, err := hasOverlappingResponsibles(c.Request.Context(), tender.OrganizationID, models.BidAuthorTypeOrganization, input.OrganizationID)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	if conflict {
+		if err := recordConflictOfInterest(c, audit.TenderEvent(user, tender, models.AuditActionConflictOfInterest), "CreateBid", input.OrganizationID); err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+		apierrors.Respond(c, apierrors.ErrConflictOfInterest)
 		return
 	}
 
@@ -58,51 +80,103 @@ func CreateBid(c *gin.Context) {
 		Version:     1,
 	}
 
-	if err := database.DB.Create(&bid).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err = database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&bid).Error; err != nil {
+			return err
+		}
 
-	// Сохраняем версию предложения
-	bidVersion := models.BidVersion{
-		BidID:       bid.ID,
-		Version:     bid.Version,
-		Name:        bid.Name,
-		Description: bid.Description,
-	}
-	if err := database.DB.Create(&bidVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохраняем версию предложения
+		if err := saveBidVersion(tx, bid, user); err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(tx, events.ForBid(events.BidCreated, bid, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionCreate), nil, bid)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
+	metrics.BidsCreated.Inc()
+
+	c.JSON(http.StatusOK, dto.NewBid(bid))
+}
+
+// bidStatuses — допустимые значения фильтра status для списков предложений.
+var bidStatuses = []string{
+	string(models.BidStatusCreated),
+	string(models.BidStatusPublished),
+	string(models.BidStatusCanceled),
+	string(models.BidStatusApproved),
+	string(models.BidStatusRejected),
+}
 
-	c.JSON(http.StatusOK, bid)
+// userBidListSpec — допустимые фильтры и сортировка предложений пользователя.
+// Автор этих предложений — сам пользователь, поэтому фильтр organization_id отбирает
+// предложения к тендерам указанных организаций.
+var userBidListSpec = utils.ListQuerySpec{
+	Statuses:           bidStatuses,
+	OrganizationColumn: "(SELECT tenders.organization_id FROM tenders WHERE tenders.id = bids.tender_id)",
+	DefaultSort:        "name",
+	Params:             []string{"username"},
+}
+
+// tenderBidListSpec — допустимые фильтры и сортировка предложений по тендеру.
+// Фильтр organization_id отбирает предложения, поданные указанными организациями.
+var tenderBidListSpec = utils.ListQuerySpec{
+	Statuses:           bidStatuses,
+	OrganizationColumn: "author_id",
+	DefaultSort:        "name",
+	Params:             []string{"username"},
 }
 
 func GetUserBids(c *gin.Context) {
 	username := c.Query("username")
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Username is required"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Фильтрация и сортировка
+	listQuery, err := utils.ParseListQuery(c, userBidListSpec)
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	var bids []models.Bid
 	var total int64
 
-	limit, offset := utils.GetPaginationParams(c)
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
-	database.DB.Model(&models.Bid{}).
-		Where("author_id = ?", user.ID).
-		Count(&total).
-		Limit(limit).Offset(offset).Order("name ASC").Find(&bids)
+	query := listQuery.Filter(database.DB.WithContext(c.Request.Context()).Model(&models.Bid{}).Where("author_id = ?", user.ID), userBidListSpec)
+	if err := query.Count(&total).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	c.Header(utils.TotalCountHeader, strconv.FormatInt(total, 10))
+
+	// Запрашивается на одну запись больше, чтобы узнать, есть ли следующая страница
+	if err := listQuery.Order(listQuery.After(query)).Limit(limit + 1).Offset(offset).Find(&bids).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	bids = pageBids(c, listQuery, bids, limit)
 
-	c.JSON(http.StatusOK, bids)
+	c.JSON(http.StatusOK, dto.NewBids(bids))
 }
 
 func GetBidStatus(c *gin.Context) {
@@ -110,25 +184,25 @@ func GetBidStatus(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметр 'username' обязателен"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"status": bid.Status})
+	c.JSON(http.StatusOK, bid.Status)
 }
 
 func UpdateBidStatus(c *gin.Context) {
@@ -137,27 +211,26 @@ func UpdateBidStatus(c *gin.Context) {
 	status := c.Query("status")
 
 	if username == "" || status == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметры 'username' и 'status' обязательны"})
+		apierrors.Respond(c, apierrors.MissingParameter("username", "status"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
-	// Проверка прав доступа (например, только автор может изменить статус)
-	if bid.AuthorID != user.ID {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	// Проверка прав доступа (только представитель автора может изменить статус)
+	if !checkBidAuthor(c, user, bid) {
 		return
 	}
 
@@ -177,18 +250,36 @@ func UpdateBidStatus(c *gin.Context) {
 		}
 	}
 	if !isValidStatus {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Некорректный статус предложения"})
+		apierrors.Respond(c, apierrors.ErrInvalidBidStatus)
+		return
+	}
+
+	// Получение тендера предложения
+	var tender models.Tender
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Обновление статуса предложения
+	before := bid
 	bid.Status = models.BidStatus(status)
-	if err := database.DB.Save(&bid).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&bid).Error; err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(tx, events.ForBid(events.BidStatusType(bid.Status), bid, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionStatusChange), before, bid)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, bid)
+	c.JSON(http.StatusOK, dto.NewBid(bid))
 }
 
 func EditBid(c *gin.Context) {
@@ -196,57 +287,70 @@ func EditBid(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметр 'username' обязателен"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
-	// Проверка прав доступа (только автор может редактировать)
-	if bid.AuthorID != user.ID {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	// Проверка прав доступа (только представитель автора может редактировать)
+	if !checkBidAuthor(c, user, bid) {
 		return
 	}
 
 	var input map[string]interface{}
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": err.Error()})
+		apierrors.Respond(c, apierrors.Validation(err))
+		return
+	}
+
+	// Получение тендера предложения
+	var tender models.Tender
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Обновление предложения
 	input["version"] = bid.Version + 1
+	before := bid
 
-	if err := database.DB.Model(&bid).Updates(input).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&bid).Updates(input).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", bid.ID).First(&bid).Error; err != nil {
+			return err
+		}
 
-	// Сохранение версии предложения
-	bidVersion := models.BidVersion{
-		BidID:       bid.ID,
-		Version:     bid.Version,
-		Name:        bid.Name,
-		Description: bid.Description,
-	}
-	if err := database.DB.Create(&bidVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохранение версии предложения
+		if err := saveBidVersion(tx, bid, user); err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(tx, events.ForBid(events.BidEdited, bid, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionEdit), before, bid)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, bid)
+	c.JSON(http.StatusOK, dto.NewBid(bid))
 }
 
 func RollbackBid(c *gin.Context) {
@@ -255,66 +359,76 @@ func RollbackBid(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметр 'username' обязателен"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	version, err := strconv.Atoi(versionParam)
 	if err != nil || version < 1 {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Некорректный номер версии"})
+		apierrors.Respond(c, apierrors.ErrInvalidVersion)
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
 	// Проверка прав доступа
-	if bid.AuthorID != user.ID {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if !checkBidAuthor(c, user, bid) {
 		return
 	}
 
 	// Получение версии предложения
 	var bidVersion models.BidVersion
-	if err := database.DB.Where("bid_id = ? AND version = ?", bid.ID, version).First(&bidVersion).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Версия не найдена"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("bid_id = ? AND version = ?", bid.ID, version).First(&bidVersion).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrVersionNotFound)
+		return
+	}
+
+	// Получение тендера предложения
+	var tender models.Tender
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Откат предложения
+	before := bid
 	bid.Name = bidVersion.Name
 	bid.Description = bidVersion.Description
 	bid.Version += 1
 
-	if err := database.DB.Save(&bid).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err = database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&bid).Error; err != nil {
+			return err
+		}
 
-	// Сохранение новой версии
-	newBidVersion := models.BidVersion{
-		BidID:       bid.ID,
-		Version:     bid.Version,
-		Name:        bid.Name,
-		Description: bid.Description,
-	}
-	if err := database.DB.Create(&newBidVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохранение новой версии
+		if err := saveBidVersion(tx, bid, user); err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(tx, events.ForBid(events.BidRolledBack, bid, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionRollback), before, bid)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, bid)
+	c.JSON(http.StatusOK, dto.NewBid(bid))
 }
 
 func SubmitBidDecision(c *gin.Context) {
@@ -323,40 +437,55 @@ func SubmitBidDecision(c *gin.Context) {
 	decision := c.Query("decision")
 
 	if username == "" || decision == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметры 'username' и 'decision' обязательны"})
+		apierrors.Respond(c, apierrors.MissingParameter("username", "decision"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
 	// Проверка прав доступа (ответственный за тендер)
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
 	// Проверка корректности решения
 	if decision != string(models.BidDecisionApproved) && decision != string(models.BidDecisionRejected) {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Некорректное решение"})
+		apierrors.Respond(c, apierrors.ErrInvalidDecision)
+		return
+	}
+
+	// Проверка конфликта интересов: представитель автора предложения должен взять самоотвод
+	conflict, err := representsBidAuthor(c.Request.Context(), user.ID, bid.AuthorType, bid.AuthorID)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	if conflict {
+		if err := recordConflictOfInterest(c, audit.BidEvent(user, bid, models.AuditActionConflictOfInterest), "SubmitBidDecision", bid.AuthorID); err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+		apierrors.Respond(c, apierrors.ErrRecusalRequired)
 		return
 	}
 
@@ -364,24 +493,48 @@ func SubmitBidDecision(c *gin.Context) {
 	// Нужно реализовать процесс кворума согласно бизнес-логике
 
 	// Для упрощения примера:
-	if decision == string(models.BidDecisionApproved) {
-		bid.Status = models.BidStatusApproved
-		// При согласовании предложения тендер автоматически закрывается
-		tender.Status = models.TenderStatusClosed
-		if err := database.DB.Save(&tender).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-			return
+	err = database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		before := bid
+		if decision == string(models.BidDecisionApproved) {
+			bid.Status = models.BidStatusApproved
+
+			// При согласовании предложения тендер автоматически закрывается
+			tenderBefore := tender
+			tender.Status = models.TenderStatusClosed
+			if err := tx.Save(&tender).Error; err != nil {
+				return err
+			}
+			if err := audit.Record(tx, c, audit.TenderEvent(user, tender, models.AuditActionStatusChange), tenderBefore, tender); err != nil {
+				return err
+			}
+		} else {
+			bid.Status = models.BidStatusRejected
 		}
-	} else {
-		bid.Status = models.BidStatusRejected
-	}
 
-	if err := database.DB.Save(&bid).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		if err := tx.Save(&bid).Error; err != nil {
+			return err
+		}
+
+		decisionEvents := []events.Event{events.ForBid(events.BidStatusType(bid.Status), bid, tender)}
+		if bid.Status == models.BidStatusApproved {
+			decisionEvents = append(decisionEvents, events.ForTender(events.TenderClosed, tender))
+		}
+		if err := outbox.Enqueue(tx, decisionEvents...); err != nil {
+			return err
+		}
+
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionDecision), before, bid)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
+	metrics.BidDecisions.WithLabelValues(decision).Inc()
+	if bid.Status == models.BidStatusApproved {
+		metrics.TenderStatusChanges.WithLabelValues(string(models.TenderStatusClosed)).Inc()
+	}
 
-	c.JSON(http.StatusOK, bid)
+	c.JSON(http.StatusOK, dto.NewBid(bid))
 }
 
 func SubmitBidFeedback(c *gin.Context) {
@@ -390,34 +543,34 @@ func SubmitBidFeedback(c *gin.Context) {
 	bidFeedback := c.Query("bidFeedback")
 
 	if username == "" || bidFeedback == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметры 'username' и 'bidFeedback' обязательны"})
+		apierrors.Respond(c, apierrors.MissingParameter("username", "bidFeedback"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования предложения
 	var bid models.Bid
-	if err := database.DB.Where("id = ?", bidID).First(&bid).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Предложение не найдено"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bidID).First(&bid).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrBidNotFound)
 		return
 	}
 
 	// Проверка прав доступа (ответственный за тендер)
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", bid.TenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
@@ -427,12 +580,21 @@ func SubmitBidFeedback(c *gin.Context) {
 		Feedback: bidFeedback,
 	}
 
-	if err := database.DB.Create(&feedback).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&feedback).Error; err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(tx, events.ForBidFeedback(bid, tender, feedback)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.BidEvent(user, bid, models.AuditActionFeedback), nil, feedback)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, bid)
+	c.JSON(http.StatusOK, dto.NewBid(bid))
 }
 
 func GetBidReviews(c *gin.Context) {
@@ -441,48 +603,56 @@ func GetBidReviews(c *gin.Context) {
 	requesterUsername := c.Query("requesterUsername")
 
 	if authorUsername == "" || requesterUsername == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметры 'authorUsername' и 'requesterUsername' обязательны"})
+		apierrors.Respond(c, apierrors.MissingParameter("authorUsername", "requesterUsername"))
 		return
 	}
 
 	// Проверка существования пользователя-запросчика
 	var requester models.User
-	if err := database.DB.Where("username = ?", requesterUsername).First(&requester).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь-запросчик не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", requesterUsername).First(&requester).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrRequesterNotFound)
 		return
 	}
 
 	// Проверка существования автора
 	var author models.User
-	if err := database.DB.Where("username = ?", authorUsername).First(&author).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Автор не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", authorUsername).First(&author).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrAuthorNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка прав доступа (запросчик должен быть ответственным за тендер)
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, requester.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, requester.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
 	// Получение отзывов на предложения автора
-	limit, offset := utils.GetPaginationParams(c)
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
 	var reviews []models.BidFeedback
-	database.DB.Joins("JOIN bids ON bid_feedbacks.bid_id = bids.id").
+	err = database.DB.WithContext(c.Request.Context()).Joins("JOIN bids ON bid_feedbacks.bid_id = bids.id").
 		Where("bids.author_id = ?", author.ID).
 		Limit(limit).Offset(offset).
-		Find(&reviews)
+		Find(&reviews).Error
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
-	c.JSON(http.StatusOK, reviews)
+	c.JSON(http.StatusOK, dto.NewBidReviews(reviews))
 }
 
 func GetBidsForTender(c *gin.Context) {
@@ -490,38 +660,103 @@ func GetBidsForTender(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметр 'username' обязателен"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Фильтрация и сортировка
+	listQuery, err := utils.ParseListQuery(c, tenderBidListSpec)
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка прав доступа
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
-	limit, offset := utils.GetPaginationParams(c)
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
 	// Получение списка предложений для указанного тендера
 	var bids []models.Bid
-	database.DB.Where("tender_id = ?", tenderID).
-		Limit(limit).Offset(offset).
-		Order("name ASC").Find(&bids)
+	var total int64
+	query := listQuery.Filter(database.DB.WithContext(c.Request.Context()).Model(&models.Bid{}).Where("tender_id = ?", tenderID), tenderBidListSpec)
+	if err := query.Count(&total).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	c.Header(utils.TotalCountHeader, strconv.FormatInt(total, 10))
+
+	if err := listQuery.Order(listQuery.After(query)).Limit(limit + 1).Offset(offset).Find(&bids).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	bids = pageBids(c, listQuery, bids, limit)
+
+	c.JSON(http.StatusOK, dto.NewBids(bids))
+}
+
+// pageBids отбрасывает лишнюю запись, запрошенную сверх limit, и выставляет курсор следующей страницы.
+func pageBids(c *gin.Context, listQuery utils.ListQuery, bids []models.Bid, limit int) []models.Bid {
+	if len(bids) <= limit {
+		return bids
+	}
+	bids = bids[:limit]
+	if limit > 0 {
+		last := bids[limit-1]
+		c.Header(utils.NextCursorHeader, listQuery.NextCursor(last.ID, map[string]interface{}{
+			"name":       last.Name,
+			"created_at": last.CreatedAt,
+			"version":    last.Version,
+		}))
+	}
+	return bids
+}
 
-	c.JSON(http.StatusOK, bids)
+// checkBidAuthor проверяет, что пользователь представляет автора предложения: является им самим
+// или ответственным за организацию-автора. При отказе ответ с ошибкой уже отправлен.
+func checkBidAuthor(c *gin.Context, user models.User, bid models.Bid) bool {
+	represents, err := representsBidAuthor(c.Request.Context(), user.ID, bid.AuthorType, bid.AuthorID)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return false
+	}
+	if !represents {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return false
+	}
+	return true
+}
+
+// saveBidVersion сохраняет снимок текущего состояния предложения вместе с автором изменения.
+func saveBidVersion(tx *gorm.DB, bid models.Bid, author models.User) error {
+	bidVersion := models.BidVersion{
+		BidID:       bid.ID,
+		Version:     bid.Version,
+		Name:        bid.Name,
+		Description: bid.Description,
+		CreatedBy:   author.ID,
+	}
+	return tx.Create(&bidVersion).Error
 }
@@ -0,0 +1,66 @@
+package controllers
+
+import (
+	"context"
+	"log/slog"
+	"tender_management_api/internal/audit"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// authorResponsibles возвращает подзапрос с ID пользователей, представляющих автора предложения:
+// ответственных за организацию-автора либо самого пользователя-автора.
+func authorResponsibles(authorType models.BidAuthorType, authorID uuid.UUID) interface{} {
+	if authorType == models.BidAuthorTypeUser {
+		return []uuid.UUID{authorID}
+	}
+	return database.DB.Model(&models.OrganizationResponsible{}).
+		Select("user_id").
+		Where("organization_id = ?", authorID)
+}
+
+// hasOverlappingResponsibles проверяет, есть ли пользователи, которые одновременно
+// являются ответственными за организацию тендера и представляют автора предложения.
+func hasOverlappingResponsibles(ctx context.Context, tenderOrgID uuid.UUID, authorType models.BidAuthorType, authorID uuid.UUID) (bool, error) {
+	if authorType == models.BidAuthorTypeOrganization && authorID == tenderOrgID {
+		return true, nil
+	}
+
+	var count int64
+	err := database.DB.WithContext(ctx).Model(&models.OrganizationResponsible{}).
+		Where("organization_id = ? AND user_id IN (?)", tenderOrgID, authorResponsibles(authorType, authorID)).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// representsBidAuthor проверяет, представляет ли пользователь автора предложения.
+func representsBidAuthor(ctx context.Context, userID uuid.UUID, authorType models.BidAuthorType, authorID uuid.UUID) (bool, error) {
+	if authorType == models.BidAuthorTypeUser {
+		return userID == authorID, nil
+	}
+
+	var count int64
+	err := database.DB.WithContext(ctx).Model(&models.OrganizationResponsible{}).
+		Where("organization_id = ? AND user_id = ?", authorID, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// recordConflictOfInterest фиксирует обнаруженный конфликт интересов в журнале аудита
+// в отдельной транзакции. Транзакции обработчика при отказе нет, поэтому запись не зависит
+// от её исхода: она подтверждает только то, что действие было заблокировано.
+// Если запись не удалась, возвращается ошибка, и обработчик отвечает ею вместо отказа
+// из-за конфликта: заблокированное действие не должно остаться без следа в журнале.
+func recordConflictOfInterest(c *gin.Context, event models.AuditEvent, action string, authorID uuid.UUID) error {
+	finding := gin.H{"blockedAction": action, "bidAuthorId": authorID}
+	if err := audit.Record(database.DB.WithContext(c.Request.Context()), c, event, nil, finding); err != nil {
+		slog.ErrorContext(c.Request.Context(), "Не удалось записать конфликт интересов в журнал аудита",
+			"request_id", requestid.FromContext(c), "error", err)
+		return err
+	}
+	return nil
+}
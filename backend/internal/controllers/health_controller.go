@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"net/http"
+	"tender_management_api/internal/health"
+	"tender_management_api/internal/i18n"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReadinessResponse struct {
+	Status     string                        `json:"status"`
+	Components map[string]ComponentReadiness `json:"components"`
+}
+
+// ComponentReadiness — состояние компонента в ответе /readyz. Detail и Error берутся из каталога
+// сообщений на языке запроса, текст исходной ошибки пишется только в лог.
+type ComponentReadiness struct {
+	Ready     bool       `json:"ready"`
+	Detail    string     `json:"detail,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	CheckedAt *time.Time `json:"checkedAt,omitempty"`
+}
+
+// Healthz сообщает, что процесс жив и обрабатывает запросы. Зависимости не проверяются.
+func Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": "ok"})
+}
+
+// Readyz сообщает, готов ли сервис принимать трафик, с состоянием каждой зависимости.
+func Readyz(c *gin.Context) {
+	lang := i18n.FromContext(c)
+	response := ReadinessResponse{Status: "ready", Components: make(map[string]ComponentReadiness)}
+	for name, component := range health.Default.Snapshot() {
+		readiness := ComponentReadiness{Ready: component.Ready, CheckedAt: component.CheckedAt}
+		if component.Detail != nil {
+			readiness.Detail = component.Detail.In(lang)
+		}
+		if component.Reason != nil {
+			readiness.Error = component.Reason.In(lang)
+		}
+		response.Components[name] = readiness
+	}
+	status := http.StatusOK
+	switch {
+	case health.Default.ShuttingDown():
+		response.Status = "shutting_down"
+		status = http.StatusServiceUnavailable
+	case !health.Default.Ready():
+		response.Status = "not_ready"
+		status = http.StatusServiceUnavailable
+	}
+	c.JSON(status, response)
+}
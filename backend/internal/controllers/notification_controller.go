@@ -0,0 +1,253 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/notifications"
+	"tender_management_api/internal/utils"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UpdateNotificationPreferencesInput struct {
+	Email        *string         `json:"email" binding:"omitempty,email,max=255"`
+	Language     *string         `json:"language" binding:"omitempty,oneof=ru en"`
+	EmailEnabled map[string]bool `json:"emailEnabled"`
+}
+
+type NotificationsResponse struct {
+	Notifications []dto.Notification `json:"notifications"`
+	UnreadCount   int64              `json:"unreadCount"`
+}
+
+type NotificationPreferencesResponse struct {
+	Email        string          `json:"email"`
+	Language     string          `json:"language"`
+	EmailEnabled map[string]bool `json:"emailEnabled"`
+}
+
+func GetNotifications(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	query := database.DB.WithContext(c.Request.Context()).Model(&models.Notification{}).Where("user_id = ?", user.ID)
+	if c.Query("unread") == "true" {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var inbox []models.Notification
+	if err := query.Limit(limit).Offset(offset).Order("created_at DESC").Find(&inbox).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	response := NotificationsResponse{Notifications: dto.NewNotifications(inbox)}
+
+	// Количество непрочитанных уведомлений
+	if err := database.DB.WithContext(c.Request.Context()).Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", user.ID).Count(&response.UnreadCount).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func MarkNotificationRead(c *gin.Context) {
+	notificationID := c.Param("notificationId")
+	username := c.Query("username")
+
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	// Проверка существования уведомления
+	var notification models.Notification
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", notificationID).First(&notification).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrNotificationNotFound)
+		return
+	}
+
+	// Проверка прав доступа
+	if notification.UserID != user.ID {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	if notification.ReadAt == nil {
+		now := time.Now()
+		notification.ReadAt = &now
+		if err := database.DB.WithContext(c.Request.Context()).Model(&notification).Update("read_at", now).Error; err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.NewNotification(notification))
+}
+
+func MarkAllNotificationsRead(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	result := database.DB.WithContext(c.Request.Context()).Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", user.ID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		apierrors.Respond(c, result.Error)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": result.RowsAffected, "unreadCount": 0})
+}
+
+func GetNotificationPreferences(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	response, err := notificationPreferences(c.Request.Context(), user)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func UpdateNotificationPreferences(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	var input UpdateNotificationPreferencesInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierrors.Respond(c, apierrors.Validation(err))
+		return
+	}
+
+	// Проверка типов событий
+	for eventType := range input.EmailEnabled {
+		if !notifications.IsEmailEvent(events.Type(eventType)) {
+			apierrors.Respond(c, apierrors.ErrUnknownEventType.WithMessage(i18n.MsgEventNotEmailed, eventType))
+			return
+		}
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		contacts := map[string]interface{}{}
+		if input.Email != nil {
+			contacts["email"] = *input.Email
+			user.Email = *input.Email
+		}
+		if input.Language != nil {
+			contacts["language"] = *input.Language
+			user.Language = *input.Language
+		}
+		if len(contacts) > 0 {
+			if err := tx.Model(&models.User{}).Where("id = ?", user.ID).Updates(contacts).Error; err != nil {
+				return err
+			}
+		}
+
+		for eventType, enabled := range input.EmailEnabled {
+			preference := models.NotificationPreference{UserID: user.ID, EventType: eventType, EmailEnabled: enabled}
+			err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "user_id"}, {Name: "event_type"}},
+				DoUpdates: clause.AssignmentColumns([]string{"email_enabled"}),
+			}).Create(&preference).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	response, err := notificationPreferences(c.Request.Context(), user)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// notificationPreferences собирает настройки уведомлений пользователя по всем событиям с письмами.
+func notificationPreferences(ctx context.Context, user models.User) (NotificationPreferencesResponse, error) {
+	response := NotificationPreferencesResponse{
+		Email:        user.Email,
+		Language:     user.Language,
+		EmailEnabled: make(map[string]bool),
+	}
+	for _, eventType := range notifications.EmailEvents {
+		enabled, err := notifications.EmailEnabled(database.DB.WithContext(ctx), user.ID, eventType)
+		if err != nil {
+			return response, err
+		}
+		response.EmailEnabled[string(eventType)] = enabled
+	}
+	return response, nil
+}
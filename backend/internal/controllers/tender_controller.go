@@ -4,12 +4,20 @@ package controllers
 import (
 	"net/http"
 	"strconv"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/audit"
 	"tender_management_api/internal/database"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/metrics"
 	"tender_management_api/internal/models"
+	"tender_management_api/internal/outbox"
 	"tender_management_api/internal/utils"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type CreateTenderInput struct {
@@ -24,21 +32,21 @@ type CreateTenderInput struct {
 func CreateTender(c *gin.Context) {
 	var input CreateTenderInput
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": err.Error()})
+		apierrors.Respond(c, apierrors.Validation(err))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", input.CreatorUsername).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", input.CreatorUsername).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка, является ли пользователь ответственным за организацию
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", input.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", input.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
@@ -51,78 +59,227 @@ func CreateTender(c *gin.Context) {
 		Version:        1,
 	}
 
-	if err := database.DB.Create(&tender).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&tender).Error; err != nil {
+			return err
+		}
 
-	// Сохраняем версию тендера
-	tenderVersion := models.TenderVersion{
-		TenderID:    tender.ID,
-		Version:     tender.Version,
-		Name:        tender.Name,
-		Description: tender.Description,
-		ServiceType: tender.ServiceType,
-	}
-	if err := database.DB.Create(&tenderVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохраняем версию тендера
+		if err := saveTenderVersion(tx, tender, user); err != nil {
+			return err
+		}
+
+		tenderEvents := []events.Event{events.ForTender(events.TenderCreated, tender)}
+		if tender.Status != models.TenderStatusCreated {
+			tenderEvents = append(tenderEvents, events.ForTender(events.TenderStatusType(tender.Status), tender))
+		}
+		if err := outbox.Enqueue(tx, tenderEvents...); err != nil {
+			return err
+		}
+
+		return audit.Record(tx, c, audit.TenderEvent(user, tender, models.AuditActionCreate), nil, tender)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
+	metrics.TenderStatusChanges.WithLabelValues(string(tender.Status)).Inc()
+
+	c.JSON(http.StatusOK, dto.NewTender(tender))
+}
+
+// escapedDescription — описание тендера с экранированными символами HTML. Фрагмент для выдачи
+// строится по нему, поэтому в ответ попадает только разметка <mark>, добавленная ts_headline,
+// а не разметка из описания.
+const escapedDescription = `replace(replace(replace(replace(replace(coalesce(description, ''), ` +
+	`'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+// tenderSearchRow — строка результата полнотекстового поиска: тендер, релевантность и фрагмент описания.
+type tenderSearchRow struct {
+	models.Tender
+	Rank    float64
+	Snippet string
+}
 
-	c.JSON(http.StatusOK, tender)
+// tenderListSpec — допустимые фильтры и сортировка общего списка тендеров.
+// В общем списке доступны только опубликованные и закрытые тендеры.
+var tenderListSpec = utils.ListQuerySpec{
+	Statuses:           []string{string(models.TenderStatusPublished), string(models.TenderStatusClosed)},
+	DefaultStatuses:    []string{string(models.TenderStatusPublished)},
+	OrganizationColumn: "organization_id",
+	DefaultSort:        "name",
+	Params:             []string{"service_type", "q"},
+}
+
+// userTenderListSpec — допустимые фильтры и сортировка тендеров пользователя.
+var userTenderListSpec = utils.ListQuerySpec{
+	Statuses: []string{
+		string(models.TenderStatusCreated),
+		string(models.TenderStatusPublished),
+		string(models.TenderStatusClosed),
+	},
+	OrganizationColumn: "organization_id",
+	DefaultSort:        "name",
+	Params:             []string{"username"},
 }
 
 func GetTenders(c *gin.Context) {
 	var tenders []models.Tender
 	var total int64
 
-	limit, offset := utils.GetPaginationParams(c)
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
-	// Фильтрация по service_type
-	serviceTypes := c.QueryArray("service_type")
+	// Фильтрация и сортировка
+	listQuery, err := utils.ParseListQuery(c, tenderListSpec)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
-	query := database.DB.Model(&models.Tender{}).Where("status = ?", models.TenderStatusPublished)
+	query := listQuery.Filter(database.DB.WithContext(c.Request.Context()).Model(&models.Tender{}), tenderListSpec)
 
-	if len(serviceTypes) > 0 {
+	// Фильтрация по service_type
+	if serviceTypes := c.QueryArray("service_type"); len(serviceTypes) > 0 {
 		query = query.Where("service_type IN ?", serviceTypes)
 	}
 
-	query.Count(&total)
+	// Полнотекстовый поиск по названию и описанию
+	if q := c.Query("q"); q != "" {
+		tsQuery := "websearch_to_tsquery('russian', ?)"
+		query = query.Where("search_vector @@ "+tsQuery, q)
+		if err := query.Count(&total).Error; err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
 
-	// Пагинация и сортировка
-	query.Limit(limit).Offset(offset).Order("name ASC").Find(&tenders)
+		query = query.Select("tenders.*, "+
+			"ts_rank(search_vector, "+tsQuery+") AS rank, "+
+			"ts_headline('russian', "+escapedDescription+", "+tsQuery+", 'StartSel=<mark>, StopSel=</mark>, MaxFragments=2') AS snippet", q, q)
+
+		// Без явной сортировки результаты упорядочиваются по релевантности, курсор для такой выдачи не поддерживается
+		if listQuery.SortRequested {
+			query = listQuery.Order(listQuery.After(query))
+		} else if listQuery.Cursor != nil {
+			apierrors.Respond(c, apierrors.InvalidParameter(i18n.MsgCursorRequiresSort))
+			return
+		} else {
+			query = query.Order("rank DESC, name ASC, id ASC")
+		}
 
-	c.JSON(http.StatusOK, tenders)
+		var results []tenderSearchRow
+		if err := query.Limit(limit + 1).Offset(offset).Scan(&results).Error; err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+
+		c.Header(utils.TotalCountHeader, strconv.FormatInt(total, 10))
+		if len(results) > limit {
+			results = results[:limit]
+			if limit > 0 && listQuery.SortRequested {
+				last := results[limit-1].Tender
+				c.Header(utils.NextCursorHeader, listQuery.NextCursor(last.ID, tenderCursorValues(last)))
+			}
+		}
+
+		response := make([]dto.TenderSearchResult, 0, len(results))
+		for _, result := range results {
+			response = append(response, dto.NewTenderSearchResult(result.Tender, result.Rank, result.Snippet))
+		}
+		c.JSON(http.StatusOK, response)
+		return
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	c.Header(utils.TotalCountHeader, strconv.FormatInt(total, 10))
+
+	// Пагинация и сортировка: запрашивается на одну запись больше, чтобы узнать, есть ли следующая страница
+	if err := listQuery.Order(listQuery.After(query)).Limit(limit + 1).Offset(offset).Find(&tenders).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	tenders = pageTenders(c, listQuery, tenders, limit)
+
+	c.JSON(http.StatusOK, dto.NewTenders(tenders))
 }
 
 func GetUserTenders(c *gin.Context) {
 	username := c.Query("username")
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Username is required"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Фильтрация и сортировка
+	listQuery, err := utils.ParseListQuery(c, userTenderListSpec)
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	var tenders []models.Tender
 	var total int64
 
-	limit, offset := utils.GetPaginationParams(c)
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
 
 	// Получение тендеров, созданных пользователем
-	database.DB.Model(&models.Tender{}).
-		Where("organization_id IN (?)", database.DB.Model(&models.OrganizationResponsible{}).
+	query := database.DB.WithContext(c.Request.Context()).Model(&models.Tender{}).
+		Where("organization_id IN (?)", database.DB.WithContext(c.Request.Context()).Model(&models.OrganizationResponsible{}).
 			Select("organization_id").
-			Where("user_id = ?", user.ID)).
-		Count(&total).
-		Limit(limit).Offset(offset).Order("name ASC").Find(&tenders)
+			Where("user_id = ?", user.ID))
+	query = listQuery.Filter(query, userTenderListSpec)
+	if err := query.Count(&total).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	c.Header(utils.TotalCountHeader, strconv.FormatInt(total, 10))
 
-	c.JSON(http.StatusOK, tenders)
+	if err := listQuery.Order(listQuery.After(query)).Limit(limit + 1).Offset(offset).Find(&tenders).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	tenders = pageTenders(c, listQuery, tenders, limit)
+
+	c.JSON(http.StatusOK, dto.NewTenders(tenders))
+}
+
+// pageTenders отбрасывает лишнюю запись, запрошенную сверх limit, и выставляет курсор следующей страницы.
+func pageTenders(c *gin.Context, listQuery utils.ListQuery, tenders []models.Tender, limit int) []models.Tender {
+	if len(tenders) <= limit {
+		return tenders
+	}
+	tenders = tenders[:limit]
+	if limit > 0 {
+		last := tenders[limit-1]
+		c.Header(utils.NextCursorHeader, listQuery.NextCursor(last.ID, tenderCursorValues(last)))
+	}
+	return tenders
+}
+
+// tenderCursorValues возвращает значения полей сортировки тендера для курсора пагинации.
+func tenderCursorValues(tender models.Tender) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       tender.Name,
+		"created_at": tender.CreatedAt,
+		"version":    tender.Version,
+	}
 }
 
 func EditTender(c *gin.Context) {
@@ -130,59 +287,65 @@ func EditTender(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Username is required"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка прав доступа
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
 	var input map[string]interface{}
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": err.Error()})
+		apierrors.Respond(c, apierrors.Validation(err))
 		return
 	}
 
 	// Обновление тендера
 	input["version"] = tender.Version + 1
+	before := tender
 
-	if err := database.DB.Model(&tender).Updates(input).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&tender).Updates(input).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("id = ?", tender.ID).First(&tender).Error; err != nil {
+			return err
+		}
 
-	// Сохранение версии
-	tenderVersion := models.TenderVersion{
-		TenderID:    tender.ID,
-		Version:     tender.Version,
-		Name:        tender.Name,
-		Description: tender.Description,
-		ServiceType: tender.ServiceType,
-	}
-	if err := database.DB.Create(&tenderVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохранение версии
+		if err := saveTenderVersion(tx, tender, user); err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(tx, events.ForTender(events.TenderEdited, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.TenderEvent(user, tender, models.AuditActionEdit), before, tender)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, tender)
+	c.JSON(http.StatusOK, dto.NewTender(tender))
 }
 
 func RollbackTender(c *gin.Context) {
@@ -191,69 +354,72 @@ func RollbackTender(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Username is required"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	version, err := strconv.Atoi(versionParam)
 	if err != nil || version < 1 {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Некорректный номер версии"})
+		apierrors.Respond(c, apierrors.ErrInvalidVersion)
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка прав доступа
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
 	// Получение версии тендера
 	var tenderVersion models.TenderVersion
-	if err := database.DB.Where("tender_id = ? AND version = ?", tender.ID, version).First(&tenderVersion).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Версия не найдена"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("tender_id = ? AND version = ?", tender.ID, version).First(&tenderVersion).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrVersionNotFound)
 		return
 	}
 
 	// Откат тендера
+	before := tender
 	tender.Name = tenderVersion.Name
 	tender.Description = tenderVersion.Description
 	tender.ServiceType = tenderVersion.ServiceType
 	tender.Version += 1
 
-	if err := database.DB.Save(&tender).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
-		return
-	}
+	err = database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tender).Error; err != nil {
+			return err
+		}
 
-	// Сохранение новой версии
-	newTenderVersion := models.TenderVersion{
-		TenderID:    tender.ID,
-		Version:     tender.Version,
-		Name:        tender.Name,
-		Description: tender.Description,
-		ServiceType: tender.ServiceType,
-	}
-	if err := database.DB.Create(&newTenderVersion).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+		// Сохранение новой версии
+		if err := saveTenderVersion(tx, tender, user); err != nil {
+			return err
+		}
+
+		if err := outbox.Enqueue(tx, events.ForTender(events.TenderRolledBack, tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.TenderEvent(user, tender, models.AuditActionRollback), before, tender)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, tender)
+	c.JSON(http.StatusOK, dto.NewTender(tender))
 }
 
 func GetTenderStatus(c *gin.Context) {
@@ -261,25 +427,25 @@ func GetTenderStatus(c *gin.Context) {
 	username := c.Query("username")
 
 	if username == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметр 'username' обязателен"})
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"status": tender.Status})
+	c.JSON(http.StatusOK, tender.Status)
 }
 
 func UpdateTenderStatus(c *gin.Context) {
@@ -288,28 +454,28 @@ func UpdateTenderStatus(c *gin.Context) {
 	status := c.Query("status")
 
 	if username == "" || status == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Параметры 'username' и 'status' обязательны"})
+		apierrors.Respond(c, apierrors.MissingParameter("username", "status"))
 		return
 	}
 
 	// Проверка существования пользователя
 	var user models.User
-	if err := database.DB.Where("username = ?", username).First(&user).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"reason": "Пользователь не существует или некорректен"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
 		return
 	}
 
 	// Проверка существования тендера
 	var tender models.Tender
-	if err := database.DB.Where("id = ?", tenderID).First(&tender).Error; err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"reason": "Тендер не найден"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
 		return
 	}
 
 	// Проверка прав доступа
 	var orgResp models.OrganizationResponsible
-	if err := database.DB.Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
-		c.JSON(http.StatusForbidden, gin.H{"reason": "Недостаточно прав для выполнения действия"})
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", tender.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
 		return
 	}
 
@@ -327,16 +493,43 @@ func UpdateTenderStatus(c *gin.Context) {
 		}
 	}
 	if !isValidStatus {
-		c.JSON(http.StatusBadRequest, gin.H{"reason": "Некорректный статус тендера"})
+		apierrors.Respond(c, apierrors.ErrInvalidTenderStatus)
 		return
 	}
 
 	// Обновление статуса тендера
+	before := tender
 	tender.Status = models.TenderStatus(status)
-	if err := database.DB.Save(&tender).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"reason": err.Error()})
+
+	err := database.DB.WithContext(c.Request.Context()).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&tender).Error; err != nil {
+			return err
+		}
+		if err := outbox.Enqueue(tx, events.ForTender(events.TenderStatusType(tender.Status), tender)); err != nil {
+			return err
+		}
+		return audit.Record(tx, c, audit.TenderEvent(user, tender, models.AuditActionStatusChange), before, tender)
+	})
+	if err != nil {
+		apierrors.Respond(c, err)
 		return
 	}
+	if tender.Status != before.Status {
+		metrics.TenderStatusChanges.WithLabelValues(string(tender.Status)).Inc()
+	}
 
-	c.JSON(http.StatusOK, tender)
+	c.JSON(http.StatusOK, dto.NewTender(tender))
+}
+
+// saveTenderVersion сохраняет снимок текущего состояния тендера вместе с автором изменения.
+func saveTenderVersion(tx *gorm.DB, tender models.Tender, author models.User) error {
+	tenderVersion := models.TenderVersion{
+		TenderID:    tender.ID,
+		Version:     tender.Version,
+		Name:        tender.Name,
+		Description: tender.Description,
+		ServiceType: tender.ServiceType,
+		CreatedBy:   author.ID,
+	}
+	return tx.Create(&tenderVersion).Error
 }
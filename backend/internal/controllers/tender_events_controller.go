@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/outbox"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const (
+	// sseRetry — интервал переподключения клиента после обрыва соединения, мс.
+	sseRetry = 3000
+	// sseKeepAlive — интервал отправки комментария, не дающего прокси закрыть соединение.
+	sseKeepAlive = 15 * time.Second
+	// sseReplayLimit — размер страницы при чтении пропущенных событий из истории.
+	sseReplayLimit = 500
+	// sseReplayOverlap — запас по времени публикации при чтении пропущенных событий. События,
+	// опубликованные незадолго до последнего полученного, отправляются повторно, а клиент
+	// отбрасывает повторы по id.
+	sseReplayOverlap = 5 * time.Second
+	// sseResetEvent — событие, после которого клиент должен переподключиться с Last-Event-ID.
+	sseResetEvent = "reset"
+	// sseWriteTimeout — срок на каждую запись в поток. Общий WriteTimeout сервера оборвал бы
+	// долгое соединение, поэтому перед каждой записью срок продлевается.
+	sseWriteTimeout = 10 * time.Second
+)
+
+// tenderEventViewer определяет, какие события тендера видны пользователю.
+type tenderEventViewer struct {
+	isOwner       bool
+	organizations map[uuid.UUID]bool
+}
+
+// canSee: ответственные за тендер видят все события; остальные пользователи видят
+// публикацию и закрытие тендера, а также события предложений своих организаций.
+func (v tenderEventViewer) canSee(event events.Event) bool {
+	if v.isOwner {
+		return true
+	}
+	if event.BidID != nil {
+		for _, id := range event.OrganizationIDs {
+			if v.organizations[id] {
+				return true
+			}
+		}
+		return false
+	}
+	return event.Type == events.TenderPublished || event.Type == events.TenderClosed
+}
+
+func StreamTenderEvents(c *gin.Context) {
+	tenderID := c.Param("tenderId")
+	username := c.Query("username")
+
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	// Проверка существования тендера
+	var tender models.Tender
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", tenderID).First(&tender).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrTenderNotFound)
+		return
+	}
+
+	// Организации пользователя
+	var responsibilities []models.OrganizationResponsible
+	if err := database.DB.WithContext(c.Request.Context()).Where("user_id = ?", user.ID).Find(&responsibilities).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+	viewer := tenderEventViewer{organizations: make(map[uuid.UUID]bool)}
+	for _, responsibility := range responsibilities {
+		viewer.organizations[responsibility.OrganizationID] = true
+	}
+	viewer.isOwner = viewer.organizations[tender.OrganizationID]
+
+	// Неопубликованный тендер виден только ответственным за него
+	if !viewer.isOwner && tender.Status == models.TenderStatusCreated {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	// Номер последнего полученного события при переподключении
+	var lastSequence int64
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("lastEventId")
+	}
+	if lastEventID != "" {
+		sequence, err := strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil || sequence < 0 {
+			apierrors.Respond(c, apierrors.InvalidParameter(i18n.MsgInvalidLastEventID))
+			return
+		}
+		lastSequence = sequence
+	}
+
+	// Подписка оформляется до чтения пропущенных событий, чтобы не потерять события между ними
+	live, unsubscribe := events.DefaultBus.Subscribe()
+	defer unsubscribe()
+
+	// Номера сообщений outbox выдаются при вставке, а не при фиксации транзакции, и сообщения
+	// публикуются не строго по номерам. Поэтому пропущенные события отбираются по времени публикации
+	// последнего полученного события с запасом, а уже отправленные отбрасываются по номеру.
+	var replayFrom time.Time
+	if lastEventID != "" {
+		var last models.OutboxMessage
+		err := database.DB.WithContext(c.Request.Context()).Where("tender_id = ? AND sequence = ? AND status = ?", tender.ID, lastSequence, models.OutboxStatusPublished).
+			Limit(1).Find(&last).Error
+		if err != nil {
+			apierrors.Respond(c, err)
+			return
+		}
+		// Неизвестный номер: отправляется вся история тендера
+		if last.PublishedAt != nil {
+			replayFrom = last.PublishedAt.Add(-sseReplayOverlap)
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	controller := http.NewResponseController(c.Writer)
+	extendWriteDeadline := func() {
+		// Ошибка означает, что writer не поддерживает сроки записи, и тогда продлевать нечего
+		_ = controller.SetWriteDeadline(time.Now().Add(sseWriteTimeout))
+	}
+
+	send := func(event events.Event) {
+		if !viewer.canSee(event) {
+			return
+		}
+		extendWriteDeadline()
+		c.Render(-1, sse.Event{
+			Id:    strconv.FormatInt(event.Sequence, 10),
+			Event: string(event.Type),
+			Retry: sseRetry,
+			Data:  event,
+		})
+		c.Writer.Flush()
+	}
+	// reset сообщает клиенту, что часть событий не была доставлена: клиенту нужно переподключиться
+	// с Last-Event-ID последнего полученного события и получить пропущенные из истории
+	reset := func() {
+		extendWriteDeadline()
+		c.Render(-1, sse.Event{Event: sseResetEvent, Retry: sseRetry, Data: gin.H{"reason": "events_dropped"}})
+		c.Writer.Flush()
+	}
+
+	// replayed — номера событий, отправленных из истории, включая последнее полученное клиентом
+	replayed := make(map[int64]bool)
+	if lastEventID != "" {
+		replayed[lastSequence] = true
+		cursorAt, cursorSequence := replayFrom, int64(0)
+		for {
+			var missed []models.OutboxMessage
+			err := database.DB.WithContext(c.Request.Context()).
+				Where("tender_id = ? AND status = ? AND (published_at, sequence) > (?, ?)", tender.ID, models.OutboxStatusPublished, cursorAt, cursorSequence).
+				Order("published_at ASC, sequence ASC").Limit(sseReplayLimit).Find(&missed).Error
+			if err != nil {
+				reset()
+				return
+			}
+			for _, message := range missed {
+				cursorAt, cursorSequence = *message.PublishedAt, message.Sequence
+				event, err := outbox.Decode(message)
+				if err != nil || replayed[event.Sequence] {
+					continue
+				}
+				send(event)
+				replayed[event.Sequence] = true
+			}
+			if len(missed) < sseReplayLimit {
+				break
+			}
+		}
+	}
+	extendWriteDeadline()
+	c.Writer.Flush()
+
+	// Событие из истории может прийти и из шины, только если шина получила его до конца чтения
+	// истории: сообщение передаётся в шину раньше, чем отмечается опубликованным. Такие события
+	// уже лежат в буфере подписки, поэтому номера из истории нужны, только пока он не разобран.
+	backlog := len(live)
+	if backlog == 0 {
+		replayed = nil
+	}
+
+	keepAlive := time.NewTicker(sseKeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case event, ok := <-live:
+			// Канал закрывается при переполнении буфера подписчика и при остановке сервиса
+			if !ok {
+				reset()
+				return
+			}
+			if backlog > 0 {
+				backlog--
+				duplicate := replayed[event.Sequence]
+				if backlog == 0 {
+					replayed = nil
+				}
+				if duplicate {
+					continue
+				}
+			}
+			if event.TenderID == tender.ID {
+				send(event)
+			}
+		case <-keepAlive.C:
+			extendWriteDeadline()
+			if _, err := c.Writer.WriteString(": keep-alive\n\n"); err != nil {
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
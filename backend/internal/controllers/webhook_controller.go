@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"tender_management_api/internal/utils"
+	"tender_management_api/internal/webhooks"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type CreateWebhookInput struct {
+	OrganizationID  uuid.UUID `json:"organizationId" binding:"required"`
+	URL             string    `json:"url" binding:"required,url,max=500"`
+	Secret          string    `json:"secret" binding:"required,min=16,max=256"`
+	Events          []string  `json:"events"`
+	CreatorUsername string    `json:"creatorUsername" binding:"required"`
+}
+
+func CreateWebhook(c *gin.Context) {
+	var input CreateWebhookInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		apierrors.Respond(c, apierrors.Validation(err))
+		return
+	}
+
+	// Проверка типов событий
+	for _, eventType := range input.Events {
+		if !events.IsKnown(events.Type(eventType)) {
+			apierrors.Respond(c, apierrors.ErrUnknownEventType.WithMessage(i18n.MsgUnknownEventType, eventType))
+			return
+		}
+	}
+
+	// Доставки к внутренним адресам сервиса запрещены
+	if err := webhooks.ValidateURL(c.Request.Context(), input.URL); err != nil {
+		apierrors.Respond(c, apierrors.ErrValidationFailed.WithMessage(i18n.MsgWebhookURLForbidden, input.URL))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", input.CreatorUsername).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	// Проверка, является ли пользователь ответственным за организацию
+	var orgResp models.OrganizationResponsible
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", input.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return
+	}
+
+	subscription := models.WebhookSubscription{
+		OrganizationID: input.OrganizationID,
+		URL:            input.URL,
+		Secret:         input.Secret,
+		Events:         strings.Join(input.Events, ","),
+		Active:         true,
+		CreatedBy:      user.ID,
+	}
+
+	if err := database.DB.WithContext(c.Request.Context()).Create(&subscription).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewWebhook(subscription))
+}
+
+func GetWebhooks(c *gin.Context) {
+	username := c.Query("username")
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return
+	}
+
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	// Получение подписок организаций, за которые отвечает пользователь
+	var subscriptions []models.WebhookSubscription
+	err = database.DB.WithContext(c.Request.Context()).Where("organization_id IN (?)", database.DB.WithContext(c.Request.Context()).Model(&models.OrganizationResponsible{}).
+		Select("organization_id").
+		Where("user_id = ?", user.ID)).
+		Limit(limit).Offset(offset).Order("created_at DESC").Find(&subscriptions).Error
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewWebhooks(subscriptions))
+}
+
+func DeleteWebhook(c *gin.Context) {
+	subscription, ok := findWebhookForResponsible(c)
+	if !ok {
+		return
+	}
+
+	// Подписка отключается, чтобы журнал доставок оставался доступен
+	if err := database.DB.WithContext(c.Request.Context()).Model(&subscription).Update("active", false).Error; err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewWebhook(subscription))
+}
+
+func GetWebhookDeliveries(c *gin.Context) {
+	subscription, ok := findWebhookForResponsible(c)
+	if !ok {
+		return
+	}
+
+	limit, offset, err := utils.GetPaginationParams(c)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	var deliveries []models.WebhookDelivery
+	err = database.DB.WithContext(c.Request.Context()).Where("subscription_id = ?", subscription.ID).
+		Limit(limit).Offset(offset).Order("created_at DESC").Find(&deliveries).Error
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewWebhookDeliveries(deliveries))
+}
+
+func RedeliverWebhook(c *gin.Context) {
+	subscription, ok := findWebhookForResponsible(c)
+	if !ok {
+		return
+	}
+
+	// Проверка существования доставки
+	var delivery models.WebhookDelivery
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ? AND subscription_id = ?", c.Param("deliveryId"), subscription.ID).First(&delivery).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrDeliveryNotFound)
+		return
+	}
+
+	redelivery, err := webhooks.Redeliver(database.DB.WithContext(c.Request.Context()), delivery)
+	if err != nil {
+		apierrors.Respond(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.NewWebhookDelivery(redelivery))
+}
+
+// findWebhookForResponsible загружает подписку из пути запроса и проверяет,
+// что пользователь отвечает за её организацию. При ошибке ответ уже отправлен.
+func findWebhookForResponsible(c *gin.Context) (models.WebhookSubscription, bool) {
+	var subscription models.WebhookSubscription
+
+	webhookID := c.Param("webhookId")
+	username := c.Query("username")
+
+	if username == "" {
+		apierrors.Respond(c, apierrors.MissingParameter("username"))
+		return subscription, false
+	}
+
+	// Проверка существования пользователя
+	var user models.User
+	if err := database.DB.WithContext(c.Request.Context()).Where("username = ?", username).First(&user).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrUserNotFound)
+		return subscription, false
+	}
+
+	// Проверка существования подписки
+	if err := database.DB.WithContext(c.Request.Context()).Where("id = ?", webhookID).First(&subscription).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrWebhookNotFound)
+		return subscription, false
+	}
+
+	// Проверка прав доступа
+	var orgResp models.OrganizationResponsible
+	if err := database.DB.WithContext(c.Request.Context()).Where("organization_id = ? AND user_id = ?", subscription.OrganizationID, user.ID).First(&orgResp).Error; err != nil {
+		apierrors.Respond(c, apierrors.ErrForbiddenNotResponsible)
+		return subscription, false
+	}
+
+	return subscription, true
+}
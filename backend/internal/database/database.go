@@ -2,38 +2,117 @@
 package database
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
 	"tender_management_api/internal/config"
+	"tender_management_api/internal/metrics"
 	"tender_management_api/internal/models"
+	"tender_management_api/internal/tracing"
+	"tender_management_api/migrations"
+	"time"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
 var DB *gorm.DB
 
-func ConnectDatabase(cfg *config.Config) {
-	dsn := cfg.PostgresConn
+// Задержки между попытками подключения при запуске растут от начальной до максимальной.
+const (
+	initialRetryDelay = 500 * time.Millisecond
+	maxRetryDelay     = 10 * time.Second
+)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+// Open подключается к базе данных без применения миграций и настраивает пул соединений.
+// Перед подключением задаётся расположение таблиц сотрудников и организаций из конфигурации.
+func Open(cfg *config.Config) (*gorm.DB, error) {
+	models.SetTables(models.Tables{
+		Schema:                  cfg.DatabaseSchema,
+		Employee:                cfg.EmployeeTable,
+		Organization:            cfg.OrganizationTable,
+		OrganizationResponsible: cfg.OrganizationResponsibleTable,
+	})
+	db, err := gorm.Open(postgres.Open(cfg.PostgresConn), &gorm.Config{Logger: queryLogger{level: logger.Warn}})
 	if err != nil {
-		log.Fatal("Не удалось подключиться к базе данных: ", err)
-	}
-
-	// Выполнение миграций
-	err = db.AutoMigrate(
-		&models.User{},
-		&models.Organization{},
-		&models.OrganizationResponsible{},
-		&models.Tender{},
-		&models.TenderVersion{},
-		&models.Bid{},
-		&models.BidVersion{},
-		&models.BidFeedback{},
-	)
+		return nil, err
+	}
+	if err := db.Use(metrics.GormPlugin{}); err != nil {
+		return nil, err
+	}
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
 	if err != nil {
-		log.Fatal("Не удалось выполнить миграции: ", err)
+		return nil, err
+	}
+	sqlDB.SetMaxOpenConns(cfg.DBMaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.DBMaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.DBConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.DBConnMaxIdleTime)
+	return db, nil
+}
+
+// OpenWithRetry подключается к базе данных, повторяя попытки с растущей задержкой,
+// пока не истечёт cfg.DBStartupTimeout. При нулевом таймауте выполняется одна попытка.
+func OpenWithRetry(ctx context.Context, cfg *config.Config) (*gorm.DB, error) {
+	deadline := time.Now().Add(cfg.DBStartupTimeout)
+	delay := initialRetryDelay
+	for attempt := 1; ; attempt++ {
+		db, err := Open(cfg)
+		if err == nil {
+			return db, nil
+		}
+		if time.Now().Add(delay).After(deadline) {
+			return nil, fmt.Errorf("база данных недоступна после %d попыток: %w", attempt, err)
+		}
+
+		slog.WarnContext(ctx, "Не удалось подключиться к базе данных, повтор",
+			"attempt", attempt, "retry_in", delay.String(), "error", err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxRetryDelay)
+	}
+}
+
+// Migrations возвращает миграции схемы, встроенные в приложение, для заданного расположения
+// таблиц сотрудников и организаций.
+func Migrations(t models.Tables) ([]Migration, error) {
+	return LoadMigrations(migrations.FS, t)
+}
+
+// ConnectDatabase подключается к базе данных, применяет миграции, проверяет схему
+// и сохраняет подключение в DB.
+func ConnectDatabase(ctx context.Context, cfg *config.Config) error {
+	db, err := OpenWithRetry(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("не удалось подключиться к базе данных: %w", err)
+	}
+
+	// Применение миграций, которые ещё не были выполнены
+	list, err := Migrations(models.CurrentTables())
+	if err != nil {
+		return fmt.Errorf("не удалось загрузить миграции: %w", err)
+	}
+	applied, err := MigrateUp(db, list)
+	if err != nil {
+		return fmt.Errorf("не удалось выполнить миграции: %w", err)
+	}
+	for _, migration := range applied {
+		slog.InfoContext(ctx, "Применена миграция", "version", migration.Version, "name", migration.Name)
+	}
+
+	// Проверка таблиц, которые ведутся вне приложения
+	if err := CheckSchema(db, models.CurrentTables()); err != nil {
+		return err
 	}
 
 	DB = db
+	return nil
 }
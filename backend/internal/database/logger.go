@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold — длительность, начиная с которой запрос записывается в журнал как медленный.
+const slowQueryThreshold = 200 * time.Millisecond
+
+// queryLogger пишет сообщения GORM в slog: ошибки запросов и медленные запросы.
+// Значения параметров в журнал не попадают, только текст SQL с плейсхолдерами.
+type queryLogger struct {
+	level logger.LogLevel
+}
+
+func (l queryLogger) LogMode(level logger.LogLevel) logger.Interface {
+	l.level = level
+	return l
+}
+
+func (l queryLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		slog.InfoContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+func (l queryLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		slog.WarnContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+func (l queryLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		slog.ErrorContext(ctx, fmt.Sprintf(msg, data...), "component", "gorm")
+	}
+}
+
+func (l queryLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	elapsed := time.Since(begin)
+	switch {
+	// Отсутствие записи — обычный исход поиска, а не сбой запроса
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= logger.Error:
+		sql, rows := fc()
+		slog.ErrorContext(ctx, "Ошибка запроса к базе данных", queryAttrs(sql, rows, elapsed, err)...)
+	case elapsed > slowQueryThreshold && l.level >= logger.Warn:
+		sql, rows := fc()
+		slog.WarnContext(ctx, "Медленный запрос к базе данных", queryAttrs(sql, rows, elapsed, err)...)
+	}
+}
+
+// ParamsFilter убирает значения параметров из текста запроса, который получает Trace.
+func (queryLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
+
+func queryAttrs(sql string, rows int64, elapsed time.Duration, err error) []any {
+	attrs := []any{
+		"component", "gorm",
+		"sql", sql,
+		"rows", rows,
+		"elapsed_ms", float64(elapsed.Microseconds()) / 1000,
+	}
+	if err != nil {
+		attrs = append(attrs, "error", err)
+	}
+	return attrs
+}
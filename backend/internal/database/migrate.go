@@ -0,0 +1,243 @@
+package database
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"tender_management_api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrationsLockID — ключ advisory-блокировки, под которой применяются миграции,
+// чтобы одновременно запущенные экземпляры не выполняли их параллельно.
+const migrationsLockID = 72_114_020
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration — версия схемы с SQL для применения и отката.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus — миграция и момент её применения. AppliedAt пуст, если миграция не применена.
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// schemaMigration — запись о применённой миграции.
+type schemaMigration struct {
+	Version   int64 `gorm:"primaryKey"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// LoadMigrations читает миграции из файловой системы, подставляет в них расположение таблиц
+// сотрудников и организаций и упорядочивает по версии. У каждой миграции должны быть и up-, и down-файл.
+func LoadMigrations(fsys fs.FS, t models.Tables) ([]Migration, error) {
+	tables := newMigrationTables(t)
+
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, file := range files {
+		match := migrationFileName.FindStringSubmatch(path.Base(file))
+		if match == nil {
+			return nil, fmt.Errorf("некорректное имя файла миграции %s", file)
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("некорректная версия миграции %s: %w", file, err)
+		}
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		text, err := renderMigration(file, string(content), tables)
+		if err != nil {
+			return nil, fmt.Errorf("миграция %s: %w", file, err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("у версии %d несколько названий: %s и %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = text
+		} else {
+			migration.Down = text
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("у миграции %d_%s должны быть up- и down-файлы", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// MigrateUp применяет все ещё не применённые миграции по возрастанию версии и возвращает их.
+// Каждая миграция выполняется в своей транзакции вместе с отметкой в schema_migrations.
+func MigrateUp(db *gorm.DB, migrations []Migration) ([]Migration, error) {
+	var applied []Migration
+	err := withMigrationLock(db, func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("миграция %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// MigrateDown откатывает steps последних применённых миграций и возвращает их в порядке отката.
+func MigrateDown(db *gorm.DB, migrations []Migration, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := withMigrationLock(db, func(conn *gorm.DB) error {
+		done, err := appliedVersions(conn)
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := migrations[i]
+			if _, ok := done[migration.Version]; !ok {
+				continue
+			}
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("откат миграции %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// MigrationStatuses возвращает состояние каждой известной миграции.
+func MigrationStatuses(db *gorm.DB, migrations []Migration) ([]MigrationStatus, error) {
+	if err := db.Exec(createSchemaMigrations).Error; err != nil {
+		return nil, err
+	}
+	done, err := appliedVersions(db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, migration := range migrations {
+		status := MigrationStatus{Migration: migration}
+		if appliedAt, ok := done[migration.Version]; ok {
+			status.AppliedAt = &appliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+const createSchemaMigrations = `CREATE TABLE IF NOT EXISTS schema_migrations (
+	version BIGINT PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// createSchemaOwnedObjects — отметки об объектах, которые миграции создали сами: таблицах, типах
+// и столбцах в таблицах, ведущихся вне приложения. Откат удаляет только отмеченные объекты.
+const createSchemaOwnedObjects = `CREATE TABLE IF NOT EXISTS schema_owned_objects (
+	kind VARCHAR(20) NOT NULL,
+	name VARCHAR(255) NOT NULL,
+	PRIMARY KEY (kind, name)
+)`
+
+// withMigrationLock выполняет fn на одном соединении под advisory-блокировкой.
+func withMigrationLock(db *gorm.DB, fn func(conn *gorm.DB) error) error {
+	return db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationsLockID).Error; err != nil {
+			return err
+		}
+		defer conn.Exec("SELECT pg_advisory_unlock(?)", migrationsLockID)
+
+		if err := conn.Exec(createSchemaMigrations).Error; err != nil {
+			return err
+		}
+		if err := conn.Exec(createSchemaOwnedObjects).Error; err != nil {
+			return err
+		}
+		return fn(conn)
+	})
+}
+
+// appliedVersions возвращает версии применённых миграций и моменты их применения.
+func appliedVersions(db *gorm.DB) (map[int64]time.Time, error) {
+	var records []schemaMigration
+	if err := db.Order("version").Find(&records).Error; err != nil {
+		return nil, err
+	}
+	versions := make(map[int64]time.Time, len(records))
+	for _, record := range records {
+		versions[record.Version] = record.AppliedAt
+	}
+	return versions, nil
+}
+
+// CheckMigrations возвращает последнюю применённую версию схемы и ошибку,
+// если какая-либо из известных миграций не применена.
+func CheckMigrations(db *gorm.DB, migrations []Migration) (int64, error) {
+	done, err := appliedVersions(db)
+	if err != nil {
+		return 0, err
+	}
+
+	var current int64
+	for version := range done {
+		current = max(current, version)
+	}
+	for _, migration := range migrations {
+		if _, ok := done[migration.Version]; !ok {
+			return current, fmt.Errorf("миграция %d_%s не применена", migration.Version, migration.Name)
+		}
+	}
+	return current, nil
+}
@@ -0,0 +1,57 @@
+package database
+
+import (
+	"strings"
+	"tender_management_api/internal/models"
+	"text/template"
+)
+
+// migrationTables — имена таблиц сотрудников и организаций, подставляемые в текст миграций.
+// Имена уже заключены в кавычки и дополнены схемой, если она задана, поэтому в SQL они
+// используются как есть: {{.Employee}}. Для функций вроде to_regclass имя передаётся
+// строковым литералом: {{literal .Employee}}.
+type migrationTables struct {
+	Employee                string
+	Organization            string
+	OrganizationResponsible string
+	OrganizationType        string
+}
+
+var migrationFuncs = template.FuncMap{"literal": quoteLiteral}
+
+func newMigrationTables(t models.Tables) migrationTables {
+	return migrationTables{
+		Employee:                quoteQualified(t.Schema, t.Employee),
+		Organization:            quoteQualified(t.Schema, t.Organization),
+		OrganizationResponsible: quoteQualified(t.Schema, t.OrganizationResponsible),
+		OrganizationType:        quoteQualified(t.Schema, "organization_type"),
+	}
+}
+
+// renderMigration подставляет имена таблиц в текст миграции.
+func renderMigration(name, text string, tables migrationTables) (string, error) {
+	tmpl, err := template.New(name).Funcs(migrationFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, tables); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func quoteQualified(schema, name string) string {
+	if schema == "" {
+		return quoteIdent(name)
+	}
+	return quoteIdent(schema) + "." + quoteIdent(name)
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteLiteral(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,125 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+	"tender_management_api/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// expectedColumn — столбец и допустимые для него типы (udt_name из information_schema).
+type expectedColumn struct {
+	name  string
+	types []string
+}
+
+// expectedTable — таблица, которую приложение читает, но не создаёт само.
+type expectedTable struct {
+	name    string
+	columns []expectedColumn
+}
+
+var (
+	uuidType      = []string{"uuid"}
+	stringTypes   = []string{"varchar", "text", "bpchar"}
+	timestampType = []string{"timestamp", "timestamptz"}
+)
+
+// expectedTables описывает таблицы сотрудников и организаций так, как их использует приложение.
+func expectedTables(t models.Tables) []expectedTable {
+	return []expectedTable{
+		{t.Employee, []expectedColumn{
+			{"id", uuidType},
+			{"username", stringTypes},
+			{"first_name", stringTypes},
+			{"last_name", stringTypes},
+			{"email", stringTypes},
+			{"language", stringTypes},
+			{"created_at", timestampType},
+			{"updated_at", timestampType},
+		}},
+		{t.Organization, []expectedColumn{
+			{"id", uuidType},
+			{"name", stringTypes},
+			{"description", stringTypes},
+			{"type", append([]string{"organization_type"}, stringTypes...)},
+			{"created_at", timestampType},
+			{"updated_at", timestampType},
+		}},
+		{t.OrganizationResponsible, []expectedColumn{
+			{"id", uuidType},
+			{"organization_id", uuidType},
+			{"user_id", uuidType},
+		}},
+	}
+}
+
+// SchemaError перечисляет все расхождения схемы базы с ожидаемой.
+type SchemaError struct {
+	Problems []string
+}
+
+func (e *SchemaError) Error() string {
+	return "схема базы данных не соответствует ожидаемой:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// CheckSchema проверяет, что таблицы сотрудников и организаций существуют и содержат нужные
+// столбцы подходящих типов. Возвращает *SchemaError со списком всех найденных расхождений.
+func CheckSchema(db *gorm.DB, t models.Tables) error {
+	schema := t.Schema
+	if schema == "" {
+		if err := db.Raw("SELECT current_schema()").Scan(&schema).Error; err != nil {
+			return err
+		}
+	}
+
+	var problems []string
+	for _, table := range expectedTables(t) {
+		var columns []struct {
+			ColumnName string
+			UdtName    string
+		}
+		err := db.Raw(`SELECT column_name, udt_name FROM information_schema.columns
+			WHERE table_schema = ? AND table_name = ?`, schema, table.name).
+			Scan(&columns).Error
+		if err != nil {
+			return err
+		}
+
+		qualified := schema + "." + table.name
+		if len(columns) == 0 {
+			problems = append(problems, fmt.Sprintf("таблица %s не найдена", qualified))
+			continue
+		}
+
+		actual := make(map[string]string, len(columns))
+		for _, column := range columns {
+			actual[column.ColumnName] = column.UdtName
+		}
+		for _, column := range table.columns {
+			udt, ok := actual[column.name]
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Sprintf("в таблице %s нет столбца %s", qualified, column.name))
+			case !contains(column.types, udt):
+				problems = append(problems, fmt.Sprintf("столбец %s.%s имеет тип %s, ожидался %s",
+					qualified, column.name, udt, strings.Join(column.types, " или ")))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return &SchemaError{Problems: problems}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
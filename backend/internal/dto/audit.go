@@ -0,0 +1,49 @@
+package dto
+
+import "tender_management_api/internal/models"
+
+// AuditEvent — запись журнала аудита в ответах API. Служебные поля цепочки хешей не возвращаются:
+// целостность журнала проверяется отдельным запросом. Redacted означает, что снимки Before и After
+// скрыты, потому что пользователь не представляет автора предложения.
+type AuditEvent struct {
+	ID             string                 `json:"id"`
+	TenderID       string                 `json:"tenderId"`
+	OrganizationID string                 `json:"organizationId,omitempty"`
+	EntityType     models.AuditEntityType `json:"entityType"`
+	EntityID       string                 `json:"entityId"`
+	Action         models.AuditAction     `json:"action"`
+	ActorUsername  string                 `json:"actorUsername"`
+	Before         models.JSONText        `json:"before"`
+	After          models.JSONText        `json:"after"`
+	Redacted       bool                   `json:"redacted,omitempty"`
+	RequestID      string                 `json:"requestId,omitempty"`
+	CreatedAt      string                 `json:"createdAt"`
+}
+
+func NewAuditEvent(event models.AuditEvent) AuditEvent {
+	result := AuditEvent{
+		ID:            event.ID.String(),
+		TenderID:      event.TenderID.String(),
+		EntityType:    event.EntityType,
+		EntityID:      event.EntityID.String(),
+		Action:        event.Action,
+		ActorUsername: event.ActorUsername,
+		Before:        event.Before,
+		After:         event.After,
+		RequestID:     event.RequestID,
+		CreatedAt:     formatTime(event.CreatedAt),
+	}
+	if event.OrganizationID != nil {
+		result.OrganizationID = event.OrganizationID.String()
+	}
+	return result
+}
+
+// NewAuditEvents преобразует список записей журнала. Пустой список сериализуется как [], а не null.
+func NewAuditEvents(events []models.AuditEvent) []AuditEvent {
+	result := make([]AuditEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, NewAuditEvent(event))
+	}
+	return result
+}
@@ -0,0 +1,80 @@
+package dto
+
+import "tender_management_api/internal/models"
+
+// Bid — предложение в ответах API.
+type Bid struct {
+	ID          string               `json:"id"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Status      models.BidStatus     `json:"status"`
+	TenderID    string               `json:"tenderId"`
+	AuthorType  models.BidAuthorType `json:"authorType"`
+	AuthorID    string               `json:"authorId"`
+	Version     int                  `json:"version"`
+	CreatedAt   string               `json:"createdAt"`
+}
+
+// BidReview — отзыв на предложение.
+type BidReview struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// BidVersion — сохранённая версия предложения.
+type BidVersion struct {
+	Version     int    `json:"version"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+func NewBid(bid models.Bid) Bid {
+	return Bid{
+		ID:          bid.ID.String(),
+		Name:        bid.Name,
+		Description: bid.Description,
+		Status:      bid.Status,
+		TenderID:    bid.TenderID.String(),
+		AuthorType:  bid.AuthorType,
+		AuthorID:    bid.AuthorID.String(),
+		Version:     bid.Version,
+		CreatedAt:   formatTime(bid.CreatedAt),
+	}
+}
+
+// NewBids преобразует список предложений. Пустой список сериализуется как [], а не null.
+func NewBids(bids []models.Bid) []Bid {
+	result := make([]Bid, 0, len(bids))
+	for _, bid := range bids {
+		result = append(result, NewBid(bid))
+	}
+	return result
+}
+
+func NewBidReview(feedback models.BidFeedback) BidReview {
+	return BidReview{
+		ID:          feedback.ID.String(),
+		Description: feedback.Feedback,
+		CreatedAt:   formatTime(feedback.CreatedAt),
+	}
+}
+
+// NewBidReviews преобразует список отзывов. Пустой список сериализуется как [], а не null.
+func NewBidReviews(feedbacks []models.BidFeedback) []BidReview {
+	result := make([]BidReview, 0, len(feedbacks))
+	for _, feedback := range feedbacks {
+		result = append(result, NewBidReview(feedback))
+	}
+	return result
+}
+
+func NewBidVersion(version models.BidVersion) BidVersion {
+	return BidVersion{
+		Version:     version.Version,
+		Name:        version.Name,
+		Description: version.Description,
+		CreatedAt:   formatTime(version.CreatedAt),
+	}
+}
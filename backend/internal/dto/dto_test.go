@@ -0,0 +1,249 @@
+package dto
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+	"tender_management_api/internal/middlewares"
+	"tender_management_api/internal/models"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
+)
+
+var specPath = filepath.Join("..", "..", "..", "задание", "openapi.yml")
+
+// createdAt — момент не в UTC и с долями секунды: в ответе он должен стать RFC3339 в UTC.
+var createdAt = time.Date(2024, 9, 1, 15, 4, 5, 123456789, time.FixedZone("MSK", 3*60*60))
+
+func loadSchemas(t *testing.T) openapi3.Schemas {
+	t.Helper()
+
+	spec, err := middlewares.LoadOpenAPISpec(specPath)
+	if err != nil {
+		t.Fatalf("загрузка спецификации: %v", err)
+	}
+	return spec.Components.Schemas
+}
+
+// serialize сериализует значение так же, как это делает c.JSON, и разбирает обратно в JSON-значение.
+func serialize(t *testing.T, value interface{}) interface{} {
+	t.Helper()
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("сериализация %T: %v", value, err)
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func keys(object map[string]interface{}) []string {
+	result := make([]string, 0, len(object))
+	for key := range object {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// TestMatchesSchema проверяет, что DTO проходят схему спецификации и содержат ровно описанные в ней поля.
+func TestMatchesSchema(t *testing.T) {
+	schemas := loadSchemas(t)
+
+	tender := models.Tender{
+		ID:             uuid.New(),
+		Name:           "Доставка товаров Казань - Москва",
+		Description:    "Доставка оборудования",
+		ServiceType:    models.ServiceTypeDelivery,
+		Status:         models.TenderStatusPublished,
+		OrganizationID: uuid.New(),
+		Version:        2,
+		CreatedAt:      createdAt,
+		UpdatedAt:      createdAt.Add(time.Hour),
+	}
+	bid := models.Bid{
+		ID:          uuid.New(),
+		Name:        "Доставка за три дня",
+		Description: "Собственный автопарк",
+		Status:      models.BidStatusCreated,
+		TenderID:    tender.ID,
+		AuthorType:  models.BidAuthorTypeOrganization,
+		AuthorID:    uuid.New(),
+		Version:     1,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt.Add(time.Hour),
+	}
+	feedback := models.BidFeedback{
+		ID:        uuid.New(),
+		BidID:     bid.ID,
+		Feedback:  "Хорошие сроки",
+		CreatedAt: createdAt,
+	}
+
+	cases := []struct {
+		schema string
+		value  interface{}
+	}{
+		{"tender", NewTender(tender)},
+		{"bid", NewBid(bid)},
+		{"bidReview", NewBidReview(feedback)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.schema, func(t *testing.T) {
+			schema := schemas[tc.schema].Value
+			value := serialize(t, tc.value)
+
+			if err := schema.VisitJSON(value); err != nil {
+				t.Fatalf("%T не соответствует схеме %s: %v", tc.value, tc.schema, err)
+			}
+
+			// Схемы не запрещают лишние поля, поэтому набор полей сверяется отдельно
+			var expected []string
+			for name := range schema.Properties {
+				expected = append(expected, name)
+			}
+			sort.Strings(expected)
+			if got := keys(value.(map[string]interface{})); strings.Join(got, ",") != strings.Join(expected, ",") {
+				t.Errorf("поля %T: %v, ожидались %v", tc.value, got, expected)
+			}
+
+			if got := value.(map[string]interface{})["createdAt"]; got != "2024-09-01T12:04:05Z" {
+				t.Errorf("createdAt = %v, ожидалось RFC3339 в UTC", got)
+			}
+		})
+	}
+}
+
+func TestListsSerializeAsArrays(t *testing.T) {
+	schemas := loadSchemas(t)
+
+	lists := map[string]interface{}{
+		"tender":    NewTenders(nil),
+		"bid":       NewBids(nil),
+		"bidReview": NewBidReviews(nil),
+	}
+	for schema, list := range lists {
+		value := serialize(t, list)
+		array := openapi3.NewArraySchema().WithItems(schemas[schema].Value)
+		if err := array.VisitJSON(value); err != nil {
+			t.Errorf("пустой список %T: %v", list, err)
+		}
+	}
+}
+
+func TestStatusesMatchSchema(t *testing.T) {
+	schemas := loadSchemas(t)
+
+	if err := schemas["tenderStatus"].Value.VisitJSON(serialize(t, models.TenderStatusClosed)); err != nil {
+		t.Errorf("статус тендера: %v", err)
+	}
+	if err := schemas["bidStatus"].Value.VisitJSON(serialize(t, models.BidStatusApproved)); err != nil {
+		t.Errorf("статус предложения: %v", err)
+	}
+}
+
+// Версии не описаны в спецификации, поэтому их поля перечислены явно.
+func TestVersionFields(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected []string
+	}{
+		{
+			NewTenderVersion(models.TenderVersion{ID: uuid.New(), TenderID: uuid.New(), Version: 3, Name: "Тендер",
+				ServiceType: models.ServiceTypeConstruction, CreatedBy: uuid.New(), CreatedAt: createdAt}),
+			[]string{"createdAt", "description", "name", "serviceType", "version"},
+		},
+		{
+			NewBidVersion(models.BidVersion{ID: uuid.New(), BidID: uuid.New(), Version: 3, Name: "Предложение",
+				CreatedBy: uuid.New(), CreatedAt: createdAt}),
+			[]string{"createdAt", "description", "name", "version"},
+		},
+	}
+	for _, tc := range cases {
+		value := serialize(t, tc.value).(map[string]interface{})
+		if got := keys(value); strings.Join(got, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("поля %T: %v, ожидались %v", tc.value, got, tc.expected)
+		}
+		if value["createdAt"] != "2024-09-01T12:04:05Z" {
+			t.Errorf("%T: createdAt = %v, ожидалось RFC3339 в UTC", tc.value, value["createdAt"])
+		}
+	}
+}
+
+// Подписки на вебхуки и их доставки не описаны в спецификации, поэтому их поля перечислены явно.
+func TestWebhookFields(t *testing.T) {
+	deliveredAt := createdAt.Add(time.Minute)
+	cases := []struct {
+		value    interface{}
+		expected []string
+	}{
+		{
+			NewWebhook(models.WebhookSubscription{ID: uuid.New(), OrganizationID: uuid.New(), URL: "https://example.com/hook",
+				Secret: "0123456789abcdef", Active: true, CreatedBy: uuid.New(), CreatedAt: createdAt}),
+			[]string{"active", "createdAt", "events", "id", "organizationId", "url"},
+		},
+		{
+			NewWebhookDelivery(models.WebhookDelivery{ID: uuid.New(), SubscriptionID: uuid.New(), EventID: uuid.New(),
+				EventType: "tender.created", Payload: `{"type":"tender.created"}`, Status: models.WebhookDeliveryDelivered,
+				Attempts: 1, ResponseStatus: 200, NextAttemptAt: createdAt, DeliveredAt: &deliveredAt, CreatedAt: createdAt}),
+			[]string{"attempts", "createdAt", "deliveredAt", "eventId", "eventType", "id", "nextAttemptAt", "payload",
+				"responseStatus", "status"},
+		},
+	}
+	for _, tc := range cases {
+		value := serialize(t, tc.value).(map[string]interface{})
+		if got := keys(value); strings.Join(got, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("поля %T: %v, ожидались %v", tc.value, got, tc.expected)
+		}
+		if value["createdAt"] != "2024-09-01T12:04:05Z" {
+			t.Errorf("%T: createdAt = %v, ожидалось RFC3339 в UTC", tc.value, value["createdAt"])
+		}
+	}
+
+	// Подписка на все события хранит пустую строку и отдаётся как пустой список
+	if events := serialize(t, NewWebhook(models.WebhookSubscription{})).(map[string]interface{})["events"]; events == nil {
+		t.Error("events = null, ожидался []")
+	}
+}
+
+// Уведомления не описаны в спецификации, поэтому их поля перечислены явно.
+func TestNotificationFields(t *testing.T) {
+	readAt := createdAt.Add(time.Hour)
+	bidID := uuid.New()
+	notification := models.Notification{ID: uuid.New(), UserID: uuid.New(), DedupKey: "bid.approved:1", EventType: "bid.approved",
+		TenderID: uuid.New(), BidID: &bidID, Title: "Предложение одобрено", Body: "Текст", ReadAt: &readAt, CreatedAt: createdAt}
+
+	value := serialize(t, NewNotification(notification)).(map[string]interface{})
+	expected := []string{"bidId", "body", "createdAt", "eventType", "id", "readAt", "tenderId", "title"}
+	if got := keys(value); strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("поля уведомления: %v, ожидались %v", got, expected)
+	}
+	if value["readAt"] != "2024-09-01T13:04:05Z" {
+		t.Errorf("readAt = %v, ожидалось RFC3339 в UTC", value["readAt"])
+	}
+}
+
+// Журнал аудита не описан в спецификации. Поля цепочки хешей в ответ не попадают.
+func TestAuditEventFields(t *testing.T) {
+	organizationID := uuid.New()
+	event := models.AuditEvent{ID: uuid.New(), ActorID: uuid.New(), ActorUsername: "user1", EntityType: models.AuditEntityBid,
+		EntityID: uuid.New(), TenderID: uuid.New(), Sequence: 3, OrganizationID: &organizationID, Action: models.AuditActionEdit,
+		Before: `{"name":"Старое"}`, After: `{"name":"Новое"}`, RequestID: "req-1", PrevHash: "ab", Hash: "cd", CreatedAt: createdAt}
+
+	value := serialize(t, NewAuditEvent(event)).(map[string]interface{})
+	expected := []string{"action", "actorUsername", "after", "before", "createdAt", "entityId", "entityType", "id",
+		"organizationId", "requestId", "tenderId"}
+	if got := keys(value); strings.Join(got, ",") != strings.Join(expected, ",") {
+		t.Errorf("поля записи аудита: %v, ожидались %v", got, expected)
+	}
+	if before, ok := value["before"].(map[string]interface{}); !ok || before["name"] != "Старое" {
+		t.Errorf("before = %v, ожидался JSON-объект снимка", value["before"])
+	}
+}
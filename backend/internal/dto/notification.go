@@ -0,0 +1,43 @@
+package dto
+
+import "tender_management_api/internal/models"
+
+// Notification — уведомление во внутреннем почтовом ящике пользователя. ReadAt пуст,
+// пока уведомление не прочитано.
+type Notification struct {
+	ID        string `json:"id"`
+	EventType string `json:"eventType"`
+	TenderID  string `json:"tenderId"`
+	BidID     string `json:"bidId,omitempty"`
+	Title     string `json:"title"`
+	Body      string `json:"body"`
+	ReadAt    string `json:"readAt,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func NewNotification(notification models.Notification) Notification {
+	result := Notification{
+		ID:        notification.ID.String(),
+		EventType: notification.EventType,
+		TenderID:  notification.TenderID.String(),
+		Title:     notification.Title,
+		Body:      notification.Body,
+		CreatedAt: formatTime(notification.CreatedAt),
+	}
+	if notification.BidID != nil {
+		result.BidID = notification.BidID.String()
+	}
+	if notification.ReadAt != nil {
+		result.ReadAt = formatTime(*notification.ReadAt)
+	}
+	return result
+}
+
+// NewNotifications преобразует список уведомлений. Пустой список сериализуется как [], а не null.
+func NewNotifications(notifications []models.Notification) []Notification {
+	result := make([]Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, NewNotification(notification))
+	}
+	return result
+}
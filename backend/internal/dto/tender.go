@@ -0,0 +1,78 @@
+// Package dto описывает тела ответов API в формате спецификации OpenAPI:
+// имена полей в camelCase, даты в RFC3339, без служебных полей моделей.
+package dto
+
+import (
+	"tender_management_api/internal/models"
+	"time"
+)
+
+// Tender — тендер в ответах API.
+type Tender struct {
+	ID             string              `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	ServiceType    models.ServiceType  `json:"serviceType"`
+	Status         models.TenderStatus `json:"status"`
+	OrganizationID string              `json:"organizationId"`
+	Version        int                 `json:"version"`
+	CreatedAt      string              `json:"createdAt"`
+}
+
+// TenderSearchResult — тендер, найденный полнотекстовым поиском, с релевантностью и фрагментом описания.
+// Snippet — HTML: текст описания экранирован, совпадения выделены тегом <mark>.
+type TenderSearchResult struct {
+	Tender
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// TenderVersion — сохранённая версия тендера.
+type TenderVersion struct {
+	Version     int                `json:"version"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	ServiceType models.ServiceType `json:"serviceType"`
+	CreatedAt   string             `json:"createdAt"`
+}
+
+func NewTender(tender models.Tender) Tender {
+	return Tender{
+		ID:             tender.ID.String(),
+		Name:           tender.Name,
+		Description:    tender.Description,
+		ServiceType:    tender.ServiceType,
+		Status:         tender.Status,
+		OrganizationID: tender.OrganizationID.String(),
+		Version:        tender.Version,
+		CreatedAt:      formatTime(tender.CreatedAt),
+	}
+}
+
+// NewTenders преобразует список тендеров. Пустой список сериализуется как [], а не null.
+func NewTenders(tenders []models.Tender) []Tender {
+	result := make([]Tender, 0, len(tenders))
+	for _, tender := range tenders {
+		result = append(result, NewTender(tender))
+	}
+	return result
+}
+
+func NewTenderSearchResult(tender models.Tender, rank float64, snippet string) TenderSearchResult {
+	return TenderSearchResult{Tender: NewTender(tender), Rank: rank, Snippet: snippet}
+}
+
+func NewTenderVersion(version models.TenderVersion) TenderVersion {
+	return TenderVersion{
+		Version:     version.Version,
+		Name:        version.Name,
+		Description: version.Description,
+		ServiceType: version.ServiceType,
+		CreatedAt:   formatTime(version.CreatedAt),
+	}
+}
+
+// formatTime форматирует момент времени в RFC3339 в UTC.
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
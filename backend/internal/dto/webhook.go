@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"strings"
+	"tender_management_api/internal/models"
+)
+
+// Webhook — подписка на события в ответах API. Секрет подписи не возвращается.
+// Пустой список событий означает подписку на все события.
+type Webhook struct {
+	ID             string   `json:"id"`
+	OrganizationID string   `json:"organizationId"`
+	URL            string   `json:"url"`
+	Events         []string `json:"events"`
+	Active         bool     `json:"active"`
+	CreatedAt      string   `json:"createdAt"`
+}
+
+// WebhookDelivery — запись журнала доставки события по подписке.
+type WebhookDelivery struct {
+	ID             string                       `json:"id"`
+	EventID        string                       `json:"eventId"`
+	EventType      string                       `json:"eventType"`
+	Payload        models.JSONText              `json:"payload"`
+	Status         models.WebhookDeliveryStatus `json:"status"`
+	Attempts       int                          `json:"attempts"`
+	ResponseStatus int                          `json:"responseStatus,omitempty"`
+	LastError      string                       `json:"lastError,omitempty"`
+	NextAttemptAt  string                       `json:"nextAttemptAt"`
+	DeliveredAt    string                       `json:"deliveredAt,omitempty"`
+	RedeliveryOf   string                       `json:"redeliveryOf,omitempty"`
+	CreatedAt      string                       `json:"createdAt"`
+}
+
+func NewWebhook(subscription models.WebhookSubscription) Webhook {
+	events := []string{}
+	if subscription.Events != "" {
+		events = strings.Split(subscription.Events, ",")
+	}
+	return Webhook{
+		ID:             subscription.ID.String(),
+		OrganizationID: subscription.OrganizationID.String(),
+		URL:            subscription.URL,
+		Events:         events,
+		Active:         subscription.Active,
+		CreatedAt:      formatTime(subscription.CreatedAt),
+	}
+}
+
+// NewWebhooks преобразует список подписок. Пустой список сериализуется как [], а не null.
+func NewWebhooks(subscriptions []models.WebhookSubscription) []Webhook {
+	result := make([]Webhook, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, NewWebhook(subscription))
+	}
+	return result
+}
+
+func NewWebhookDelivery(delivery models.WebhookDelivery) WebhookDelivery {
+	result := WebhookDelivery{
+		ID:             delivery.ID.String(),
+		EventID:        delivery.EventID.String(),
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  formatTime(delivery.NextAttemptAt),
+		CreatedAt:      formatTime(delivery.CreatedAt),
+	}
+	if delivery.DeliveredAt != nil {
+		result.DeliveredAt = formatTime(*delivery.DeliveredAt)
+	}
+	if delivery.RedeliveryOf != nil {
+		result.RedeliveryOf = delivery.RedeliveryOf.String()
+	}
+	return result
+}
+
+// NewWebhookDeliveries преобразует журнал доставок. Пустой список сериализуется как [], а не null.
+func NewWebhookDeliveries(deliveries []models.WebhookDelivery) []WebhookDelivery {
+	result := make([]WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, NewWebhookDelivery(delivery))
+	}
+	return result
+}
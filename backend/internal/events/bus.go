@@ -0,0 +1,77 @@
+package events
+
+import "sync"
+
+// subscriberBuffer — размер буфера канала подписчика. Медленный подписчик не блокирует публикацию:
+// если буфер переполнен, подписка отменяется и канал закрывается, чтобы подписчик узнал о потере
+// событий и восстановил их из истории, а не пропустил молча.
+const subscriberBuffer = 64
+
+// Bus — шина событий внутри процесса.
+type Bus struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]chan Event
+	closed      bool
+}
+
+// DefaultBus — общая шина событий сервиса.
+var DefaultBus = NewBus()
+
+func NewBus() *Bus {
+	return &Bus{subscribers: make(map[int]chan Event)}
+}
+
+// Subscribe регистрирует подписчика. Вызов возвращённой функции отменяет подписку и закрывает канал.
+// Канал также закрывается при остановке шины и при переполнении буфера подписчика.
+func (b *Bus) Subscribe() (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan Event, subscriberBuffer)
+	if b.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = ch
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		// Канал мог быть уже закрыт вместе с шиной
+		if _, ok := b.subscribers[id]; ok {
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+}
+
+// Close закрывает каналы всех подписчиков, завершая их чтение. Новые подписки после этого
+// сразу получают закрытый канал. Используется при остановке сервиса.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for id, ch := range b.subscribers {
+		delete(b.subscribers, id)
+		close(ch)
+	}
+}
+
+// Publish рассылает событие всем подписчикам. Подписчик с переполненным буфером отключается.
+func (b *Bus) Publish(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for id, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package events
+
+import (
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Type string
+
+const (
+	TenderCreated       Type = "tender.created"
+	TenderEdited        Type = "tender.edited"
+	TenderRolledBack    Type = "tender.rolled_back"
+	TenderStatusChanged Type = "tender.status_changed"
+	TenderPublished     Type = "tender.published"
+	TenderClosed        Type = "tender.closed"
+
+	BidCreated       Type = "bid.created"
+	BidEdited        Type = "bid.edited"
+	BidRolledBack    Type = "bid.rolled_back"
+	BidStatusChanged Type = "bid.status_changed"
+	BidPublished     Type = "bid.published"
+	BidCanceled      Type = "bid.canceled"
+	BidApproved      Type = "bid.approved"
+	BidRejected      Type = "bid.rejected"
+	BidFeedback      Type = "bid.feedback"
+)
+
+// Types — все поддерживаемые типы событий.
+var Types = []Type{
+	TenderCreated, TenderEdited, TenderRolledBack, TenderStatusChanged, TenderPublished, TenderClosed,
+	BidCreated, BidEdited, BidRolledBack, BidStatusChanged, BidPublished, BidCanceled, BidApproved, BidRejected, BidFeedback,
+}
+
+// IsKnown проверяет, что тип события поддерживается.
+func IsKnown(t Type) bool {
+	for _, known := range Types {
+		if known == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Event — изменение состояния тендера или предложения. Data — тендер, предложение или отзыв
+// в том же виде, что и в ответах API (пакет dto): событие уходит наружу в вебхуках и потоках SSE.
+type Event struct {
+	ID         uuid.UUID   `json:"id"`
+	Sequence   int64       `json:"sequence,omitempty"`
+	DedupKey   string      `json:"dedupKey,omitempty"`
+	Type       Type        `json:"type"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	TenderID   uuid.UUID   `json:"tenderId"`
+	BidID      *uuid.UUID  `json:"bidId,omitempty"`
+	Data       interface{} `json:"data"`
+
+	// OrganizationIDs — организации, которых касается событие.
+	OrganizationIDs []uuid.UUID `json:"-"`
+}
+
+// ForTender создаёт событие тендера. Событие касается организации тендера.
+func ForTender(t Type, tender models.Tender) Event {
+	return Event{
+		ID:              uuid.New(),
+		Type:            t,
+		OccurredAt:      time.Now().UTC(),
+		TenderID:        tender.ID,
+		Data:            dto.NewTender(tender),
+		OrganizationIDs: []uuid.UUID{tender.OrganizationID},
+	}
+}
+
+// ForBid создаёт событие предложения. Событие касается организации тендера и организации-автора.
+func ForBid(t Type, bid models.Bid, tender models.Tender) Event {
+	return forBid(t, bid, tender, dto.NewBid(bid))
+}
+
+// ForBidFeedback создаёт событие об отзыве на предложение.
+func ForBidFeedback(bid models.Bid, tender models.Tender, feedback models.BidFeedback) Event {
+	return forBid(BidFeedback, bid, tender, dto.NewBidReview(feedback))
+}
+
+func forBid(t Type, bid models.Bid, tender models.Tender, data interface{}) Event {
+	organizationIDs := []uuid.UUID{tender.OrganizationID}
+	if bid.AuthorType == models.BidAuthorTypeOrganization && bid.AuthorID != tender.OrganizationID {
+		organizationIDs = append(organizationIDs, bid.AuthorID)
+	}
+
+	bidID := bid.ID
+	return Event{
+		ID:              uuid.New(),
+		Type:            t,
+		OccurredAt:      time.Now().UTC(),
+		TenderID:        bid.TenderID,
+		BidID:           &bidID,
+		Data:            data,
+		OrganizationIDs: organizationIDs,
+	}
+}
+
+// TenderStatusType возвращает тип события для нового статуса тендера.
+func TenderStatusType(status models.TenderStatus) Type {
+	switch status {
+	case models.TenderStatusPublished:
+		return TenderPublished
+	case models.TenderStatusClosed:
+		return TenderClosed
+	default:
+		return TenderStatusChanged
+	}
+}
+
+// BidStatusType возвращает тип события для нового статуса предложения.
+func BidStatusType(status models.BidStatus) Type {
+	switch status {
+	case models.BidStatusPublished:
+		return BidPublished
+	case models.BidStatusCanceled:
+		return BidCanceled
+	case models.BidStatusApproved:
+		return BidApproved
+	case models.BidStatusRejected:
+		return BidRejected
+	default:
+		return BidStatusChanged
+	}
+}
@@ -0,0 +1,47 @@
+package events
+
+import (
+	"encoding/json"
+	"tender_management_api/internal/models"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// TestEventDataUsesAPIFields проверяет, что данные события сериализуются так же, как ответы API:
+// поля в camelCase, без полей моделей GORM.
+func TestEventDataUsesAPIFields(t *testing.T) {
+	tender := models.Tender{ID: uuid.New(), Name: "Доставка", OrganizationID: uuid.New(), Version: 1}
+	bid := models.Bid{ID: uuid.New(), TenderID: tender.ID, AuthorType: models.BidAuthorTypeUser, AuthorID: uuid.New(), Version: 1}
+	feedback := models.BidFeedback{ID: uuid.New(), BidID: bid.ID, Feedback: "Хорошие сроки"}
+
+	cases := map[string]struct {
+		event   Event
+		want    []string
+		missing []string
+	}{
+		"tender":   {ForTender(TenderCreated, tender), []string{"id", "organizationId", "createdAt"}, []string{"ID", "OrganizationID"}},
+		"bid":      {ForBid(BidCreated, bid, tender), []string{"id", "tenderId", "authorType", "authorId"}, []string{"ID", "AuthorID"}},
+		"feedback": {ForBidFeedback(bid, tender, feedback), []string{"id", "description"}, []string{"BidID", "Feedback"}},
+	}
+	for name, tc := range cases {
+		raw, err := json.Marshal(tc.event.Data)
+		if err != nil {
+			t.Fatalf("%s: сериализация: %v", name, err)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(raw, &fields); err != nil {
+			t.Fatalf("%s: разбор: %v", name, err)
+		}
+		for _, key := range tc.want {
+			if _, ok := fields[key]; !ok {
+				t.Errorf("%s: нет поля %q в %s", name, key, raw)
+			}
+		}
+		for _, key := range tc.missing {
+			if _, ok := fields[key]; ok {
+				t.Errorf("%s: лишнее поле %q в %s", name, key, raw)
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PingDatabase периодически проверяет соединение с базой данных и версию её схемы и записывает
+// результаты в state под именами ComponentDatabase и ComponentMigrations, пока не будет отменён контекст.
+func PingDatabase(ctx context.Context, db *gorm.DB, state *State, interval time.Duration) {
+	migrations, err := database.Migrations(models.CurrentTables())
+	if err != nil {
+		slog.ErrorContext(ctx, "Не удалось загрузить миграции", "error", err)
+		state.Set(ComponentMigrations, err)
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		err := ping(ctx, db, interval)
+		if ctx.Err() != nil {
+			return
+		}
+		// В лог попадают только смены состояния, а не каждая проверка
+		previous, checked := state.Snapshot()[ComponentDatabase]
+		first := !checked || previous.CheckedAt == nil
+		state.Set(ComponentDatabase, err)
+		switch {
+		case err != nil && (first || previous.Ready):
+			slog.ErrorContext(ctx, "База данных недоступна", "error", err)
+		case err == nil && !first && !previous.Ready:
+			slog.InfoContext(ctx, "Соединение с базой данных восстановлено")
+		}
+
+		if err == nil {
+			previous, checked := state.Snapshot()[ComponentMigrations]
+			first := !checked || previous.CheckedAt == nil
+			version, err := database.CheckMigrations(db.WithContext(ctx), migrations)
+			state.SetDetail(ComponentMigrations, &i18n.Message{Key: i18n.MsgHealthSchemaVersion, Args: []interface{}{version}}, err)
+			switch {
+			case err != nil && (first || previous.Ready):
+				slog.ErrorContext(ctx, "Схема базы данных не соответствует миграциям", "version", version, "error", err)
+			case err == nil && !first && !previous.Ready:
+				slog.InfoContext(ctx, "Схема базы данных соответствует миграциям", "version", version)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ping проверяет соединение, ожидая ответа не дольше timeout.
+func ping(ctx context.Context, db *gorm.DB, timeout time.Duration) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,124 @@
+package health
+
+import (
+	"sync"
+	"tender_management_api/internal/i18n"
+	"time"
+)
+
+// Имена компонентов, из которых складывается готовность сервиса.
+const (
+	ComponentDatabase   = "database"
+	ComponentMigrations = "migrations"
+	ComponentOutbox     = "outbox"
+	ComponentWebhooks   = "webhooks"
+)
+
+// ComponentStatus — результат последней проверки компонента. Detail и Reason — сообщения каталога:
+// текст ошибки проверки может раскрывать устройство инфраструктуры, поэтому он пишется только в лог.
+type ComponentStatus struct {
+	Ready     bool
+	Detail    *i18n.Message
+	Reason    *i18n.Message
+	CheckedAt *time.Time
+}
+
+// State хранит состояние зависимостей сервиса. Сервис готов, когда готовы все известные компоненты
+// и не начата остановка.
+type State struct {
+	mu           sync.RWMutex
+	components   map[string]ComponentStatus
+	staleAfter   map[string]time.Duration
+	shuttingDown bool
+}
+
+// Default — общее состояние готовности сервиса.
+var Default = NewState()
+
+func NewState() *State {
+	return &State{
+		components: make(map[string]ComponentStatus),
+		staleAfter: make(map[string]time.Duration),
+	}
+}
+
+// Register объявляет компонент, без которого сервис не готов. Если staleAfter больше нуля,
+// компонент считается неготовым, когда его состояние не обновлялось дольше staleAfter.
+func (s *State) Register(name string, staleAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.staleAfter[name] = staleAfter
+	if _, ok := s.components[name]; !ok {
+		s.components[name] = ComponentStatus{Reason: &i18n.Message{Key: i18n.MsgHealthNotChecked}}
+	}
+}
+
+// Set записывает результат проверки компонента: nil означает, что компонент готов.
+// Саму ошибку вызывающий пишет в лог.
+func (s *State) Set(name string, err error) {
+	s.SetDetail(name, nil, err)
+}
+
+// SetDetail записывает результат проверки компонента вместе с поясняющими сведениями.
+func (s *State) SetDetail(name string, detail *i18n.Message, err error) {
+	now := time.Now()
+	status := ComponentStatus{Ready: err == nil, Detail: detail, CheckedAt: &now}
+	if err != nil {
+		status.Reason = &i18n.Message{Key: i18n.MsgHealthCheckFailed}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.components[name] = status
+}
+
+// Snapshot возвращает копию состояния всех компонентов с учётом устаревших проверок.
+func (s *State) Snapshot() map[string]ComponentStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	snapshot := make(map[string]ComponentStatus, len(s.components))
+	for name, status := range s.components {
+		if maxAge := s.staleAfter[name]; maxAge > 0 && status.CheckedAt != nil && now.Sub(*status.CheckedAt) > maxAge {
+			status.Ready = false
+			status.Reason = &i18n.Message{Key: i18n.MsgHealthStale, Args: []interface{}{maxAge.String()}}
+		}
+		snapshot[name] = status
+	}
+	return snapshot
+}
+
+// Ready сообщает, готовы ли все компоненты. Пока ни один компонент не проверен
+// или после начала остановки сервис не готов.
+func (s *State) Ready() bool {
+	if s.ShuttingDown() {
+		return false
+	}
+
+	snapshot := s.Snapshot()
+	if len(snapshot) == 0 {
+		return false
+	}
+	for _, status := range snapshot {
+		if !status.Ready {
+			return false
+		}
+	}
+	return true
+}
+
+// StartShutdown отмечает начало остановки: с этого момента сервис не готов принимать трафик.
+func (s *State) StartShutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.shuttingDown = true
+}
+
+// ShuttingDown сообщает, начата ли остановка сервиса.
+func (s *State) ShuttingDown() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.shuttingDown
+}
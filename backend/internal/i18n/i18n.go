@@ -0,0 +1,91 @@
+// Package i18n содержит каталог сообщений API на поддерживаемых языках
+// и выбор языка ответа по заголовку Accept-Language.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/text/language"
+)
+
+// Поддерживаемые языки. Русский используется по умолчанию.
+const (
+	LanguageRussian = "ru"
+	LanguageEnglish = "en"
+	DefaultLanguage = LanguageRussian
+)
+
+// Languages — поддерживаемые языки; первый используется по умолчанию.
+var Languages = []string{LanguageRussian, LanguageEnglish}
+
+// ContentLanguageHeader — заголовок ответа с языком сообщений.
+const ContentLanguageHeader = "Content-Language"
+
+//go:embed locales
+var localeFiles embed.FS
+
+// catalogues хранит сообщения по языку и ключу.
+var catalogues = mustLoadCatalogues()
+
+var matcher = language.NewMatcher([]language.Tag{language.Russian, language.English})
+
+func mustLoadCatalogues() map[string]map[Key]string {
+	loaded := make(map[string]map[Key]string)
+	for _, lang := range Languages {
+		data, err := localeFiles.ReadFile("locales/" + lang + ".json")
+		if err != nil {
+			panic(err)
+		}
+		catalogue := make(map[Key]string)
+		if err := json.Unmarshal(data, &catalogue); err != nil {
+			panic(fmt.Sprintf("некорректный каталог сообщений %s: %v", lang, err))
+		}
+		loaded[lang] = catalogue
+	}
+	return loaded
+}
+
+// Message — сообщение каталога вместе с аргументами подстановки.
+type Message struct {
+	Key  Key
+	Args []interface{}
+}
+
+// In возвращает текст сообщения на заданном языке.
+func (m Message) In(lang string) string {
+	return T(lang, m.Key, m.Args...)
+}
+
+// T возвращает текст сообщения на заданном языке. Если перевода нет,
+// используется язык по умолчанию, а в крайнем случае — сам ключ.
+func T(lang string, key Key, args ...interface{}) string {
+	text, ok := catalogues[lang][key]
+	if !ok {
+		text, ok = catalogues[DefaultLanguage][key]
+	}
+	if !ok {
+		return string(key)
+	}
+	if len(args) == 0 {
+		return text
+	}
+	return fmt.Sprintf(text, args...)
+}
+
+// FromAcceptLanguage выбирает поддерживаемый язык по значению заголовка Accept-Language.
+func FromAcceptLanguage(header string) string {
+	tag, _ := language.MatchStrings(matcher, header)
+	base, _ := tag.Base()
+	if _, ok := catalogues[base.String()]; ok {
+		return base.String()
+	}
+	return DefaultLanguage
+}
+
+// FromContext выбирает язык ответа на запрос.
+func FromContext(c *gin.Context) string {
+	return FromAcceptLanguage(c.GetHeader("Accept-Language"))
+}
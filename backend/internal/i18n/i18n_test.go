@@ -0,0 +1,165 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// declaredKeys собирает значения всех констант типа Key из keys.go.
+func declaredKeys(t *testing.T) []Key {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), "keys.go", nil, 0)
+	if err != nil {
+		t.Fatalf("разбор keys.go: %v", err)
+	}
+
+	var keys []Key
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if ident, ok := value.Type.(*ast.Ident); !ok || ident.Name != "Key" {
+				continue
+			}
+			for _, v := range value.Values {
+				literal, ok := v.(*ast.BasicLit)
+				if !ok {
+					continue
+				}
+				key, err := strconv.Unquote(literal.Value)
+				if err != nil {
+					t.Fatalf("некорректный ключ %s: %v", literal.Value, err)
+				}
+				keys = append(keys, Key(key))
+			}
+		}
+	}
+	if len(keys) == 0 {
+		t.Fatal("в keys.go не найдено ни одного ключа")
+	}
+	return keys
+}
+
+func TestDeclaredKeysAreTranslated(t *testing.T) {
+	for _, key := range declaredKeys(t) {
+		for _, lang := range Languages {
+			if _, ok := catalogues[lang][key]; !ok {
+				t.Errorf("нет перевода ключа %q для языка %s", key, lang)
+			}
+		}
+	}
+}
+
+func TestCataloguesHaveSameKeys(t *testing.T) {
+	declared := make(map[Key]bool)
+	for _, key := range declaredKeys(t) {
+		declared[key] = true
+	}
+
+	for _, lang := range Languages {
+		for key := range catalogues[lang] {
+			if !declared[key] {
+				t.Errorf("ключ %q из каталога %s не объявлен в keys.go", key, lang)
+			}
+			for _, other := range Languages {
+				if _, ok := catalogues[other][key]; !ok {
+					t.Errorf("ключ %q есть в каталоге %s, но отсутствует в %s", key, lang, other)
+				}
+			}
+		}
+	}
+}
+
+func TestCataloguesUseSamePlaceholders(t *testing.T) {
+	placeholder := regexp.MustCompile(`%(\[\d+\])?[a-z]`)
+	for key, text := range catalogues[DefaultLanguage] {
+		expected := len(placeholder.FindAllString(text, -1))
+		for _, lang := range Languages {
+			if got := len(placeholder.FindAllString(catalogues[lang][key], -1)); got != expected {
+				t.Errorf("ключ %q: в каталоге %s %d подстановок, ожидалось %d", key, lang, got, expected)
+			}
+		}
+	}
+}
+
+// TestBindingRulesAreTranslated проверяет, что для каждого правила из тегов binding есть сообщение.
+func TestBindingRulesAreTranslated(t *testing.T) {
+	bindingTag := regexp.MustCompile("binding:\"([^\"]*)\"")
+	root := filepath.Join("..")
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.HasSuffix(path, ".go") {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		for _, match := range bindingTag.FindAllStringSubmatch(string(data), -1) {
+			for _, rule := range strings.Split(match[1], ",") {
+				name := strings.SplitN(rule, "=", 2)[0]
+				if name == "omitempty" || name == "" {
+					continue
+				}
+				if _, ok := validationKeys[name]; !ok {
+					t.Errorf("%s: нет сообщения для правила валидации %q", path, name)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                        LanguageRussian,
+		"en":                      LanguageEnglish,
+		"en-US,en;q=0.9":          LanguageEnglish,
+		"ru-RU,ru;q=0.9,en;q=0.8": LanguageRussian,
+		"de-DE,en;q=0.5":          LanguageEnglish,
+		"fr":                      LanguageRussian,
+		"не язык":                 LanguageRussian,
+	}
+	for header, expected := range cases {
+		if got := FromAcceptLanguage(header); got != expected {
+			t.Errorf("FromAcceptLanguage(%q) = %s, ожидалось %s", header, got, expected)
+		}
+	}
+}
+
+func TestValidationMessage(t *testing.T) {
+	type input struct {
+		Name   string `json:"name" validate:"required,max=3"`
+		Status string `json:"status" validate:"oneof=Created Published"`
+	}
+
+	validate := validator.New()
+	validate.SetTagName("validate")
+	err := validate.Struct(input{Name: "long", Status: "Closed"})
+
+	got := ValidationMessage(LanguageEnglish, err)
+	expected := "Field 'Name' must be at most 3; Field 'Status' must be one of: Created Published"
+	if got != expected {
+		t.Errorf("ValidationMessage = %q, ожидалось %q", got, expected)
+	}
+
+	if got := ValidationMessage(LanguageRussian, os.ErrInvalid); got != T(LanguageRussian, MsgMalformedBody) {
+		t.Errorf("ошибка разбора тела переведена как %q", got)
+	}
+}
@@ -0,0 +1,81 @@
+package i18n
+
+// Key — ключ сообщения в каталоге. Каждый ключ должен быть переведён на все поддерживаемые языки.
+type Key string
+
+// Заголовки ошибок API.
+const (
+	ErrorValidationFailed        Key = "error.VALIDATION_FAILED"
+	ErrorMissingParameter        Key = "error.MISSING_PARAMETER"
+	ErrorInvalidParameter        Key = "error.INVALID_PARAMETER"
+	ErrorInvalidTenderStatus     Key = "error.INVALID_TENDER_STATUS"
+	ErrorInvalidBidStatus        Key = "error.INVALID_BID_STATUS"
+	ErrorInvalidDecision         Key = "error.INVALID_DECISION"
+	ErrorInvalidVersion          Key = "error.INVALID_VERSION"
+	ErrorUnknownEventType        Key = "error.UNKNOWN_EVENT_TYPE"
+	ErrorTokenMissing            Key = "error.TOKEN_MISSING"
+	ErrorTokenMalformed          Key = "error.TOKEN_MALFORMED"
+	ErrorTokenInvalid            Key = "error.TOKEN_INVALID"
+	ErrorUserNotFound            Key = "error.USER_NOT_FOUND"
+	ErrorRequesterNotFound       Key = "error.REQUESTER_NOT_FOUND"
+	ErrorAuthorNotFound          Key = "error.AUTHOR_NOT_FOUND"
+	ErrorForbiddenNotResponsible Key = "error.FORBIDDEN_NOT_RESPONSIBLE"
+	ErrorConflictOfInterest      Key = "error.FORBIDDEN_CONFLICT_OF_INTEREST"
+	ErrorRecusalRequired         Key = "error.FORBIDDEN_RECUSAL_REQUIRED"
+	ErrorTenderNotFound          Key = "error.TENDER_NOT_FOUND"
+	ErrorBidNotFound             Key = "error.BID_NOT_FOUND"
+	ErrorVersionNotFound         Key = "error.VERSION_NOT_FOUND"
+	ErrorNotificationNotFound    Key = "error.NOTIFICATION_NOT_FOUND"
+	ErrorWebhookNotFound         Key = "error.WEBHOOK_NOT_FOUND"
+	ErrorDeliveryNotFound        Key = "error.DELIVERY_NOT_FOUND"
+	ErrorInternal                Key = "error.INTERNAL_ERROR"
+)
+
+// Уточнения к ошибкам в параметрах запроса.
+const (
+	MsgParameterRequired     Key = "param.required"
+	MsgParametersRequired    Key = "param.required_pair"
+	MsgUnknownParameter      Key = "param.unknown"
+	MsgInvalidParameter      Key = "param.invalid"
+	MsgInvalidParameterValue Key = "param.invalid_value"
+	MsgInvalidTimeFormat     Key = "param.invalid_time_format"
+	MsgInvalidPeriod         Key = "param.invalid_period"
+	MsgInvalidLimit          Key = "param.invalid_limit"
+	MsgInvalidOffset         Key = "param.invalid_offset"
+	MsgCursorWithOffset      Key = "param.cursor_with_offset"
+	MsgCursorRequiresSort    Key = "param.cursor_requires_sort"
+	MsgInvalidLastEventID    Key = "param.invalid_last_event_id"
+	MsgUnknownEventType      Key = "event.unknown"
+	MsgEventNotEmailed       Key = "event.not_emailed"
+	MsgWebhookURLForbidden   Key = "webhook.url_forbidden"
+	MsgContractViolation     Key = "contract.violation"
+)
+
+// Ошибки валидации тела запроса. Аргументы: имя поля и параметр правила.
+const (
+	MsgMalformedBody      Key = "validation.malformed_body"
+	MsgValidationInvalid  Key = "validation.invalid"
+	MsgValidationRequired Key = "validation.required"
+	MsgValidationMax      Key = "validation.max"
+	MsgValidationMin      Key = "validation.min"
+	MsgValidationOneOf    Key = "validation.oneof"
+	MsgValidationEmail    Key = "validation.email"
+	MsgValidationURL      Key = "validation.url"
+)
+
+// Причины нарушения цепочки журнала аудита.
+const (
+	MsgChainSequenceBroken Key = "audit.chain.sequence_broken"
+	MsgChainPrevHashBroken Key = "audit.chain.prev_hash_mismatch"
+	MsgChainUnreadable     Key = "audit.chain.unreadable"
+	MsgChainHashMismatch   Key = "audit.chain.hash_mismatch"
+	MsgChainHeadMismatch   Key = "audit.chain.head_mismatch"
+)
+
+// Состояние компонентов в ответе /readyz.
+const (
+	MsgHealthNotChecked    Key = "health.not_checked"
+	MsgHealthCheckFailed   Key = "health.check_failed"
+	MsgHealthStale         Key = "health.stale"
+	MsgHealthSchemaVersion Key = "health.schema_version"
+)
@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// validationKeys сопоставляет правила из тегов binding с сообщениями каталога.
+var validationKeys = map[string]Key{
+	"required": MsgValidationRequired,
+	"max":      MsgValidationMax,
+	"min":      MsgValidationMin,
+	"oneof":    MsgValidationOneOf,
+	"email":    MsgValidationEmail,
+	"url":      MsgValidationURL,
+}
+
+// UseJSONFieldNames настраивает валидатор Gin так, чтобы в ошибках фигурировали имена полей из JSON,
+// а не из структур Go.
+func UseJSONFieldNames() {
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if !ok {
+		return
+	}
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+		if name == "" || name == "-" {
+			return field.Name
+		}
+		return name
+	})
+}
+
+// ValidationMessage переводит ошибку разбора или валидации тела запроса.
+func ValidationMessage(lang string, err error) string {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return T(lang, MsgMalformedBody)
+	}
+
+	messages := make([]string, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		key, ok := validationKeys[fieldError.Tag()]
+		if !ok {
+			key = MsgValidationInvalid
+		}
+		messages = append(messages, T(lang, key, fieldError.Field(), fieldError.Param()))
+	}
+	return strings.Join(messages, "; ")
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "tender_management"
+
+var (
+	// HTTPRequestDuration — длительность обработки HTTP-запросов по шаблону маршрута и коду ответа.
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Длительность обработки HTTP-запросов.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// DBQueryDuration — длительность запросов к базе данных по виду операции и таблице.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Длительность запросов к базе данных.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	// TenderStatusChanges — число тендеров, перешедших в каждый статус, включая создание.
+	// Счётчик обнуляется при перезапуске; текущее число тендеров по статусам — TendersByStatus.
+	TenderStatusChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tender_status_changes_total",
+		Help:      "Число переходов тендеров в статус, включая создание тендера.",
+	}, []string{"status"})
+
+	// BidsCreated — число созданных предложений.
+	BidsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bids_created_total",
+		Help:      "Число созданных предложений.",
+	})
+
+	// BidDecisions — число решений по предложениям по итогу решения.
+	BidDecisions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bid_decisions_total",
+		Help:      "Число решений по предложениям.",
+	}, []string{"decision"})
+
+	// AuthFailures — число запросов, отклонённых при аутентификации, по причине отказа.
+	AuthFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "auth_failures_total",
+		Help:      "Число запросов, отклонённых при аутентификации.",
+	}, []string{"reason"})
+)
+
+// Handler отдаёт метрики в формате Prometheus.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
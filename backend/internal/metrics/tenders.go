@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"tender_management_api/internal/models"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"gorm.io/gorm"
+)
+
+// tenderStatusInterval — как часто пересчитывается TendersByStatus.
+const tenderStatusInterval = 30 * time.Second
+
+// TendersByStatus — число тендеров в каждом статусе по данным базы. Значение общее для всех
+// экземпляров сервиса, поэтому при агрегации по экземплярам берётся max, а не sum.
+var TendersByStatus = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: namespace,
+	Name:      "tenders_by_status",
+	Help:      "Число тендеров в каждом статусе.",
+}, []string{"status"})
+
+// tenderStatuses — статусы, которые публикуются всегда, даже если тендеров в них нет.
+var tenderStatuses = []models.TenderStatus{models.TenderStatusCreated, models.TenderStatusPublished, models.TenderStatusClosed}
+
+// RefreshTendersByStatus пересчитывает TendersByStatus сразу и затем каждые tenderStatusInterval,
+// пока не будет отменён контекст. При ошибке запроса остаются последние известные значения.
+func RefreshTendersByStatus(ctx context.Context, db *gorm.DB) {
+	ticker := time.NewTicker(tenderStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := refreshTendersByStatus(db.WithContext(ctx)); err != nil && ctx.Err() == nil {
+			slog.WarnContext(ctx, "Не удалось подсчитать тендеры по статусам", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func refreshTendersByStatus(db *gorm.DB) error {
+	var rows []struct {
+		Status models.TenderStatus
+		Count  int64
+	}
+	if err := db.Model(&models.Tender{}).Select("status, count(*) AS count").Group("status").Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	counts := make(map[models.TenderStatus]int64, len(tenderStatuses))
+	for _, status := range tenderStatuses {
+		counts[status] = 0
+	}
+	for _, row := range rows {
+		counts[row.Status] = row.Count
+	}
+	for status, count := range counts {
+		TendersByStatus.WithLabelValues(string(status)).Set(float64(count))
+	}
+	return nil
+}
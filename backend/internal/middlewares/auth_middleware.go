@@ -2,39 +2,56 @@
 package middlewares
 
 import (
-	"net/http"
+	"errors"
 	"strings"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/metrics"
 	"tender_management_api/internal/utils"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
 )
 
-func AuthMiddleware() gin.HandlerFunc {
+// authUserKey — ключ контекста с пользователем, которому выдан токен запроса.
+const authUserKey = "authUser"
+
+// AuthMiddleware пропускает только запросы с действующим JWT, подписанным секретом jwtSecret.
+func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+	secret := []byte(jwtSecret)
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"reason": "Отсутствует токен авторизации"})
-			c.Abort()
+			metrics.AuthFailures.WithLabelValues("missing").Inc()
+			apierrors.Respond(c, apierrors.ErrTokenMissing)
 			return
 		}
 
 		tokenParts := strings.Split(authHeader, " ")
 		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{"reason": "Неверный формат токена авторизации"})
-			c.Abort()
+			metrics.AuthFailures.WithLabelValues("malformed").Inc()
+			apierrors.Respond(c, apierrors.ErrTokenMalformed)
 			return
 		}
 
 		token := tokenParts[1]
 
 		// Проверка валидности токена
-		isValid, err := utils.ValidateToken(token)
-		if err != nil || !isValid {
-			c.JSON(http.StatusUnauthorized, gin.H{"reason": "Недействительный токен"})
-			c.Abort()
+		claims, err := utils.ParseToken(token, secret)
+		if err != nil {
+			metrics.AuthFailures.WithLabelValues(tokenFailureReason(err)).Inc()
+			apierrors.Respond(c, apierrors.ErrTokenInvalid)
 			return
 		}
+		c.Set(authUserKey, utils.TokenSubject(claims))
 
 		c.Next()
 	}
 }
+
+// tokenFailureReason отделяет истёкшие токены от остальных недействительных.
+func tokenFailureReason(err error) string {
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return "expired"
+	}
+	return "invalid"
+}
@@ -0,0 +1,67 @@
+// internal/middlewares/logging_middleware.go
+package middlewares
+
+import (
+	"log/slog"
+	"runtime/debug"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/requestid"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Logger записывает в журнал каждый обработанный запрос: идентификатор, маршрут, код ответа,
+// длительность, пользователя и код ошибки. Ответы 4xx пишутся с уровнем WARN, 5xx — ERROR.
+// Пользователь берётся из проверенного токена: параметры запроса в журнал не попадают.
+func Logger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startedAt := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		attrs := []slog.Attr{
+			slog.String("request_id", requestid.FromContext(c)),
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(startedAt).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if user := c.GetString(authUserKey); user != "" {
+			attrs = append(attrs, slog.String("user", user))
+		}
+		if code := apierrors.CodeFromContext(c); code != "" {
+			attrs = append(attrs, slog.String("error_code", string(code)))
+		}
+		if span := trace.SpanContextFromContext(c.Request.Context()); span.IsValid() {
+			attrs = append(attrs, slog.String("trace_id", span.TraceID().String()))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+		slog.LogAttrs(c.Request.Context(), level, "HTTP-запрос", attrs...)
+	}
+}
+
+// Recovery отвечает INTERNAL_ERROR на панику в обработчике и записывает её в журнал со стеком.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, recovered any) {
+		slog.ErrorContext(c.Request.Context(), "Паника при обработке запроса",
+			"request_id", requestid.FromContext(c),
+			"panic", recovered,
+			"stack", string(debug.Stack()))
+		apierrors.Respond(c, apierrors.ErrInternal)
+	})
+}
@@ -0,0 +1,30 @@
+// internal/middlewares/metrics_middleware.go
+package middlewares
+
+import (
+	"strconv"
+	"tender_management_api/internal/metrics"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute — метка запросов, не попавших ни в один маршрут. Путь запроса в метку
+// не попадает, иначе произвольные URL раздували бы число временных рядов.
+const unmatchedRoute = "unmatched"
+
+// Metrics записывает длительность обработки запроса по шаблону маршрута и коду ответа.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		startedAt := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.HTTPRequestDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(startedAt).Seconds())
+	}
+}
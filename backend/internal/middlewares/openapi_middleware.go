@@ -0,0 +1,224 @@
+// internal/middlewares/openapi_middleware.go
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/requestid"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// LoadOpenAPISpec загружает и проверяет спецификацию OpenAPI.
+// Примеры в спецификации не проверяются: они иллюстративные и не всегда соответствуют схемам.
+func LoadOpenAPISpec(path string) (*openapi3.T, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(context.Background(), openapi3.DisableExamplesValidation()); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// OpenAPIValidator проверяет запросы на соответствие спецификации. Запрос, нарушающий контракт,
+// отклоняется с кодом 400. При validateResponses ответы тоже сверяются со спецификацией,
+// а найденные расхождения записываются в лог. Маршруты, не описанные в спецификации, не проверяются.
+func OpenAPIValidator(doc *openapi3.T, validateResponses bool) gin.HandlerFunc {
+	basePath := ""
+	if len(doc.Servers) > 0 {
+		if path, err := doc.Servers[0].BasePath(); err == nil && path != "/" {
+			basePath = path
+		}
+	}
+
+	options := &openapi3filter.Options{
+		// Авторизацию проверяет AuthMiddleware
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		// Значения по умолчанию подставляют обработчики, запрос не изменяется
+		SkipSettingDefaults: true,
+		MultiError:          true,
+	}
+
+	return func(c *gin.Context) {
+		route := findRoute(doc, basePath, c)
+		if route == nil {
+			c.Next()
+			return
+		}
+
+		// Имена параметров в маршрутах Gin могут отличаться от спецификации, поэтому они сопоставляются по позиции
+		pathParams := make(map[string]string, len(c.Params))
+		for i, name := range templateParams(route.Path) {
+			if i < len(c.Params) {
+				pathParams[name] = c.Params[i].Value
+			}
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:    c.Request,
+			PathParams: pathParams,
+			Route:      route,
+			Options:    options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			apierrors.Respond(c, apierrors.ErrValidationFailed.WithMessage(i18n.MsgContractViolation, describeViolation(err)))
+			return
+		}
+
+		if !validateResponses {
+			c.Next()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		header := c.Writer.Header().Clone()
+		// Ошибки отдаются как application/problem+json — JSON-совместимое расширение ErrorResponse
+		if strings.HasPrefix(header.Get("Content-Type"), apierrors.ContentType) {
+			header.Set("Content-Type", "application/json")
+		}
+		responseInput := &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 c.Writer.Status(),
+			Header:                 header,
+			Options:                options,
+		}
+		responseInput.SetBodyBytes(recorder.body.Bytes())
+		if err := openapi3filter.ValidateResponse(c.Request.Context(), responseInput); err != nil {
+			slog.WarnContext(c.Request.Context(), "Ответ не соответствует спецификации OpenAPI",
+				"request_id", requestid.FromContext(c), "method", c.Request.Method, "route", c.FullPath(), "error", err)
+		}
+	}
+}
+
+// describeViolation описывает нарушение контракта для ответа клиенту: где найдено нарушение и в чём оно.
+// Текст SchemaError из kin-openapi включает дамп схемы и присланное значение, поэтому ошибки схемы
+// описываются здесь, а не через Error().
+func describeViolation(err error) string {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		parts := make([]string, 0, len(e))
+		for _, inner := range e {
+			parts = append(parts, describeViolation(inner))
+		}
+		return strings.Join(parts, " | ")
+	case *openapi3filter.RequestError:
+		reason := e.Reason
+		if e.Err != nil {
+			if inner := describeViolation(e.Err); reason == "" || reason == e.Err.Error() {
+				reason = inner
+			} else {
+				reason += ": " + inner
+			}
+		}
+		switch {
+		case e.Parameter != nil:
+			return fmt.Sprintf("parameter %q in %s has an error: %s", e.Parameter.Name, e.Parameter.In, reason)
+		case e.RequestBody != nil:
+			return "request body has an error: " + reason
+		default:
+			return reason
+		}
+	case *openapi3.SchemaError:
+		reason := e.Reason
+		switch {
+		case e.Origin != nil:
+			reason = describeViolation(e.Origin)
+		case reason == "":
+			reason = fmt.Sprintf("doesn't match schema %q", e.SchemaField)
+		}
+		if pointer := e.JSONPointer(); len(pointer) > 0 {
+			return fmt.Sprintf("error at %q: %s", "/"+strings.Join(pointer, "/"), reason)
+		}
+		return reason
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		return describeViolation(schemaErr)
+	}
+	return err.Error()
+}
+
+// findRoute находит операцию спецификации, соответствующую маршруту Gin.
+func findRoute(doc *openapi3.T, basePath string, c *gin.Context) *routers.Route {
+	fullPath := c.FullPath()
+	if fullPath == "" || !strings.HasPrefix(fullPath, basePath) {
+		return nil
+	}
+
+	// Маршрут Gin вида /tenders/:tenderId переводится в шаблон OpenAPI /tenders/{tenderId}
+	segments := strings.Split(strings.TrimPrefix(fullPath, basePath), "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + strings.TrimPrefix(segment, ":") + "}"
+		}
+	}
+	path := strings.Join(segments, "/")
+
+	pathItem := doc.Paths.Find(path)
+	if pathItem == nil {
+		return nil
+	}
+	operation := pathItem.GetOperation(c.Request.Method)
+	if operation == nil {
+		return nil
+	}
+
+	// Шаблон пути берётся из спецификации, чтобы имена параметров совпадали с ней
+	for specPath, item := range doc.Paths.Map() {
+		if item == pathItem {
+			path = specPath
+			break
+		}
+	}
+
+	return &routers.Route{
+		Spec:      doc,
+		Path:      path,
+		PathItem:  pathItem,
+		Method:    c.Request.Method,
+		Operation: operation,
+	}
+}
+
+// templateParams возвращает имена параметров шаблона пути OpenAPI по порядку.
+func templateParams(path string) []string {
+	var names []string
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			names = append(names, strings.Trim(segment, "{}"))
+		}
+	}
+	return names
+}
+
+// responseRecorder сохраняет копию тела ответа для проверки по спецификации.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+func (r *responseRecorder) WriteString(s string) (int, error) {
+	r.body.WriteString(s)
+	return r.ResponseWriter.WriteString(s)
+}
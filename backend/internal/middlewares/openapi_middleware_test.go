@@ -0,0 +1,89 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+const testSpec = `
+openapi: 3.0.0
+info: {title: test, version: "1"}
+paths:
+  /items:
+    post:
+      parameters:
+        - {name: limit, in: query, schema: {type: integer, maximum: 50}}
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name: {type: string, maxLength: 5}
+      responses:
+        "200": {description: ok}
+`
+
+// TestOpenAPIValidatorMessages проверяет, что в ответ попадают место и причина нарушения,
+// но не дамп схемы и не присланное значение.
+func TestOpenAPIValidatorMessages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(testSpec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	router := gin.New()
+	router.Use(OpenAPIValidator(doc, false))
+	router.POST("/items", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	cases := []struct {
+		target, body string
+		want         []string
+	}{
+		{"/items", `{"name":"секретное значение"}`, []string{"request body has an error", `"/name"`, "maximum string length is 5"}},
+		{"/items?limit=100", `{"name":"ok"}`, []string{`parameter "limit" in query`, "number must be at most 50"}},
+	}
+	for _, tc := range cases {
+		request := httptest.NewRequest(http.MethodPost, tc.target, strings.NewReader(tc.body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, request)
+
+		if recorder.Code != http.StatusBadRequest {
+			t.Fatalf("%s: код ответа %d: %s", tc.target, recorder.Code, recorder.Body)
+		}
+		var problem struct {
+			Detail string `json:"detail"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+			t.Fatal(err)
+		}
+		detail := problem.Detail
+		for _, want := range tc.want {
+			if !strings.Contains(detail, want) {
+				t.Errorf("%s: в ответе нет %q: %s", tc.target, want, detail)
+			}
+		}
+		for _, leaked := range []string{"Schema:", "Value:", "секретное значение"} {
+			if strings.Contains(detail, leaked) {
+				t.Errorf("%s: ответ содержит %q: %s", tc.target, leaked, detail)
+			}
+		}
+	}
+	if openapi3.SchemaErrorDetailsDisabled {
+		t.Error("валидатор изменил глобальную настройку openapi3.SchemaErrorDetailsDisabled")
+	}
+}
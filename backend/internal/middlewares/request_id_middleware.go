@@ -0,0 +1,17 @@
+// internal/middlewares/request_id_middleware.go
+package middlewares
+
+import (
+	"tender_management_api/internal/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestID назначает запросу идентификатор: берёт его из X-Request-ID или создаёт новый
+// и возвращает клиенту в том же заголовке.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Header(requestid.Header, requestid.Ensure(c))
+		c.Next()
+	}
+}
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditEntityType string
+
+const (
+	AuditEntityTender AuditEntityType = "Tender"
+	AuditEntityBid    AuditEntityType = "Bid"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate             AuditAction = "Create"
+	AuditActionEdit               AuditAction = "Edit"
+	AuditActionStatusChange       AuditAction = "StatusChange"
+	AuditActionRollback           AuditAction = "Rollback"
+	AuditActionDecision           AuditAction = "Decision"
+	AuditActionFeedback           AuditAction = "Feedback"
+	AuditActionConflictOfInterest AuditAction = "ConflictOfInterest"
+)
+
+// ErrAuditEventImmutable возвращается при попытке изменить или удалить событие аудита.
+var ErrAuditEventImmutable = errors.New("audit events are append-only")
+
+// AuditEvent — запись журнала аудита. Журнал только пополняется: записи не изменяются и не удаляются.
+// События одного тендера образуют цепочку: каждая запись хранит хеш предыдущей и хеш собственного содержимого.
+type AuditEvent struct {
+	ID             uuid.UUID       `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	ActorID        uuid.UUID       `gorm:"type:uuid;not null"`
+	ActorUsername  string          `gorm:"type:varchar(50);not null"`
+	EntityType     AuditEntityType `gorm:"type:varchar(20);not null;index:idx_audit_events_entity"`
+	EntityID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_audit_events_entity"`
+	TenderID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_audit_events_chain"`
+	Sequence       int64           `gorm:"index:idx_audit_events_chain"`
+	OrganizationID *uuid.UUID      `gorm:"type:uuid;index"`
+	Action         AuditAction     `gorm:"type:varchar(30);not null"`
+	Before         JSONText        `gorm:"type:jsonb"`
+	After          JSONText        `gorm:"type:jsonb"`
+	RequestID      string          `gorm:"type:varchar(100)"`
+	PrevHash       string          `gorm:"type:varchar(64)"`
+	Hash           string          `gorm:"type:varchar(64)"`
+	CreatedAt      time.Time       `gorm:"default:CURRENT_TIMESTAMP;index"`
+}
+
+// AuditChainHead — последнее звено цепочки событий тендера. Обновляется в той же транзакции,
+// что и запись события, и позволяет заметить удаление записей с конца цепочки.
+type AuditChainHead struct {
+	TenderID  uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Sequence  int64     `gorm:"not null"`
+	Hash      string    `gorm:"type:varchar(64);not null"`
+	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// JSONText хранит JSON-документ в текстовом виде и отдаёт его в ответах без экранирования.
+type JSONText string
+
+func (j JSONText) MarshalJSON() ([]byte, error) {
+	if j == "" {
+		return []byte("null"), nil
+	}
+	return []byte(j), nil
+}
+
+func (AuditEvent) BeforeUpdate(*gorm.DB) error {
+	return ErrAuditEventImmutable
+}
+
+func (AuditEvent) BeforeDelete(*gorm.DB) error {
+	return ErrAuditEventImmutable
+}
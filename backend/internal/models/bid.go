@@ -49,6 +49,7 @@ type BidVersion struct {
 	Version     int       `gorm:"not null"`
 	Name        string    `gorm:"type:varchar(100);not null"`
 	Description string    `gorm:"type:varchar(500)"`
+	CreatedBy   uuid.UUID `gorm:"type:uuid"`
 	CreatedAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
 }
 
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationPreference — настройка уведомлений пользователя о событии.
+// Отсутствие записи означает, что уведомления включены.
+type NotificationPreference struct {
+	ID           uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_notification_preferences_user_event"`
+	EventType    string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_notification_preferences_user_event"`
+	EmailEnabled bool      `gorm:"not null"`
+}
+
+// Notification — уведомление во внутреннем почтовом ящике пользователя.
+// DedupKey события не даёт создать повторное уведомление при повторной публикации.
+type Notification struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	UserID    uuid.UUID  `gorm:"type:uuid;not null;index;uniqueIndex:idx_notifications_user_event"`
+	DedupKey  string     `gorm:"type:varchar(200);not null;uniqueIndex:idx_notifications_user_event"`
+	EventType string     `gorm:"type:varchar(50);not null"`
+	TenderID  uuid.UUID  `gorm:"type:uuid;not null"`
+	BidID     *uuid.UUID `gorm:"type:uuid"`
+	Title     string     `gorm:"type:varchar(300);not null"`
+	Body      string     `gorm:"type:text"`
+	ReadAt    *time.Time `gorm:"default:null"`
+	CreatedAt time.Time  `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// EmailDelivery отмечает письмо о событии, отправленное пользователю. При повторной публикации
+// события письма получают только те, кому отправить не удалось.
+type EmailDelivery struct {
+	DedupKey string    `gorm:"type:varchar(200);primaryKey"`
+	UserID   uuid.UUID `gorm:"type:uuid;primaryKey"`
+	SentAt   time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
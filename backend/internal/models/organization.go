@@ -18,7 +18,7 @@ type Organization struct {
 	ID          uuid.UUID        `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
 	Name        string           `gorm:"type:varchar(100);not null"`
 	Description string           `gorm:"type:text"`
-	Type        OrganizationType `gorm:"type:varchar(10)"`
+	Type        OrganizationType `gorm:"type:organization_type"`
 	CreatedAt   time.Time        `gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt   time.Time        `gorm:"default:CURRENT_TIMESTAMP"`
 }
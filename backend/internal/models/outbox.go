@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending   OutboxStatus = "Pending"
+	OutboxStatusPublished OutboxStatus = "Published"
+)
+
+// OutboxMessage — событие, сохранённое в одной транзакции с изменением и ожидающее публикации.
+// Sequence задаёт порядок публикации, DedupKey позволяет получателям отбрасывать повторы.
+type OutboxMessage struct {
+	Sequence        int64        `gorm:"primaryKey;autoIncrement;index:idx_outbox_messages_published,priority:3"`
+	DedupKey        string       `gorm:"type:varchar(200);not null;uniqueIndex"`
+	EventID         uuid.UUID    `gorm:"type:uuid;not null"`
+	EventType       string       `gorm:"type:varchar(50);not null"`
+	TenderID        uuid.UUID    `gorm:"type:uuid;not null;index;index:idx_outbox_messages_published,priority:1"`
+	BidID           *uuid.UUID   `gorm:"type:uuid"`
+	OrganizationIDs string       `gorm:"type:varchar(1000)"`
+	Payload         JSONText     `gorm:"type:jsonb;not null"`
+	Status          OutboxStatus `gorm:"type:varchar(20);not null;index:idx_outbox_messages_due"`
+	Attempts        int          `gorm:"default:0"`
+	LastError       string       `gorm:"type:varchar(1000)"`
+	AvailableAt     time.Time    `gorm:"index:idx_outbox_messages_due"`
+	PublishedAt     *time.Time   `gorm:"default:null;index:idx_outbox_messages_published,priority:2"`
+	CreatedAt       time.Time    `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// OutboxDelivery отмечает, что сообщение outbox передано получателю. Повторная публикация
+// после сбоя одного получателя не затрагивает тех, кто сообщение уже получил.
+type OutboxDelivery struct {
+	MessageSequence int64     `gorm:"primaryKey;autoIncrement:false"`
+	Sink            string    `gorm:"type:varchar(50);primaryKey"`
+	DeliveredAt     time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
@@ -0,0 +1,59 @@
+package models
+
+// Tables — расположение таблиц сотрудников и организаций. Эти таблицы ведутся вне приложения,
+// поэтому их схема и имена задаются в конфигурации.
+type Tables struct {
+	Schema                  string
+	Employee                string
+	Organization            string
+	OrganizationResponsible string
+}
+
+// DefaultTables — таблицы в том виде, в котором они описаны в задании.
+var DefaultTables = Tables{
+	Employee:                "employee",
+	Organization:            "organization",
+	OrganizationResponsible: "organization_responsible",
+}
+
+var tables = DefaultTables
+
+// SetTables задаёт расположение таблиц; незаданные имена берутся из DefaultTables.
+// Вызывается до первого обращения к базе: GORM запоминает имя таблицы при первом разборе модели.
+func SetTables(t Tables) {
+	if t.Employee == "" {
+		t.Employee = DefaultTables.Employee
+	}
+	if t.Organization == "" {
+		t.Organization = DefaultTables.Organization
+	}
+	if t.OrganizationResponsible == "" {
+		t.OrganizationResponsible = DefaultTables.OrganizationResponsible
+	}
+	tables = t
+}
+
+// CurrentTables возвращает действующее расположение таблиц.
+func CurrentTables() Tables {
+	return tables
+}
+
+// Qualified возвращает имя таблицы со схемой, если она задана.
+func (t Tables) Qualified(table string) string {
+	if t.Schema == "" {
+		return table
+	}
+	return t.Schema + "." + table
+}
+
+func (User) TableName() string {
+	return tables.Qualified(tables.Employee)
+}
+
+func (Organization) TableName() string {
+	return tables.Qualified(tables.Organization)
+}
+
+func (OrganizationResponsible) TableName() string {
+	return tables.Qualified(tables.OrganizationResponsible)
+}
@@ -41,5 +41,6 @@ type TenderVersion struct {
 	Name        string      `gorm:"type:varchar(100);not null"`
 	Description string      `gorm:"type:varchar(500)"`
 	ServiceType ServiceType `gorm:"type:varchar(20)"`
+	CreatedBy   uuid.UUID   `gorm:"type:uuid"`
 	CreatedAt   time.Time   `gorm:"default:CURRENT_TIMESTAMP"`
 }
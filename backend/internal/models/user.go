@@ -11,6 +11,8 @@ type User struct {
 	Username  string    `gorm:"type:varchar(50);unique;not null"`
 	FirstName string    `gorm:"type:varchar(50)"`
 	LastName  string    `gorm:"type:varchar(50)"`
+	Email     string    `gorm:"type:varchar(255)"`
+	Language  string    `gorm:"type:varchar(2);default:'ru'"`
 	CreatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
 	UpdatedAt time.Time `gorm:"default:CURRENT_TIMESTAMP"`
 }
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "Pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "Failed"
+)
+
+// WebhookSubscription — подписка организации на события. Пустой список событий означает подписку на все события.
+// Secret хранится в открытом виде, потому что нужен для подписи каждой доставки; доступ к таблице
+// равносилен знанию секретов. В ответах API секрет не возвращается, в том числе сразу после создания.
+type WebhookSubscription struct {
+	ID             uuid.UUID `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index"`
+	URL            string    `gorm:"type:varchar(500);not null"`
+	Secret         string    `gorm:"type:varchar(256);not null" json:"-"`
+	Events         string    `gorm:"type:varchar(1000)"`
+	Active         bool      `gorm:"default:true"`
+	CreatedBy      uuid.UUID `gorm:"type:uuid"`
+	CreatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time `gorm:"default:CURRENT_TIMESTAMP"`
+}
+
+// WebhookDelivery — запись журнала доставки события по подписке.
+// Для каждой пары подписки и события существует одна исходная доставка; повторные отправки
+// вручную создают новые записи со ссылкой на исходную.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `gorm:"type:uuid;default:uuid_generate_v4();primaryKey"`
+	SubscriptionID uuid.UUID             `gorm:"type:uuid;not null;index;uniqueIndex:idx_webhook_deliveries_event,where:redelivery_of IS NULL"`
+	EventID        uuid.UUID             `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_event,where:redelivery_of IS NULL"`
+	EventType      string                `gorm:"type:varchar(50);not null"`
+	Payload        JSONText              `gorm:"type:jsonb;not null"`
+	Status         WebhookDeliveryStatus `gorm:"type:varchar(20);not null;index:idx_webhook_deliveries_due"`
+	Attempts       int                   `gorm:"default:0"`
+	ResponseStatus int                   `gorm:"default:0"`
+	LastError      string                `gorm:"type:varchar(1000)"`
+	NextAttemptAt  time.Time             `gorm:"index:idx_webhook_deliveries_due"`
+	DeliveredAt    *time.Time            `gorm:"default:null"`
+	RedeliveryOf   *uuid.UUID            `gorm:"type:uuid"`
+	CreatedAt      time.Time             `gorm:"default:CURRENT_TIMESTAMP"`
+	UpdatedAt      time.Time             `gorm:"default:CURRENT_TIMESTAMP"`
+}
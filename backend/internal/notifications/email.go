@@ -0,0 +1,171 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EmailSink отправляет письма о событиях предложений: авторам — об одобрении, отклонении
+// и отзывах, ответственным за тендер — о новых предложениях.
+type EmailSink struct {
+	DB     *gorm.DB
+	Sender Sender
+}
+
+func (EmailSink) Name() string {
+	return "email"
+}
+
+func (s EmailSink) Publish(ctx context.Context, event events.Event) error {
+	if !IsEmailEvent(event.Type) || event.BidID == nil {
+		return nil
+	}
+	db := s.DB.WithContext(ctx)
+
+	var tender models.Tender
+	if err := db.Where("id = ?", event.TenderID).First(&tender).Error; err != nil {
+		return err
+	}
+	var bid models.Bid
+	if err := db.Where("id = ?", *event.BidID).First(&bid).Error; err != nil {
+		return err
+	}
+
+	data := TemplateData{TenderName: tender.Name, BidName: bid.Name}
+	if event.Type == events.BidFeedback {
+		var feedback dto.BidReview
+		if err := decodeData(event.Data, &feedback); err != nil {
+			return err
+		}
+		data.Feedback = feedback.Description
+	}
+
+	recipients, err := Recipients(db, event.Type, tender, &bid)
+	if err != nil {
+		return err
+	}
+
+	var sent []uuid.UUID
+	if err := db.Model(&models.EmailDelivery{}).Where("dedup_key = ?", event.DedupKey).Pluck("user_id", &sent).Error; err != nil {
+		return err
+	}
+	alreadySent := make(map[uuid.UUID]bool, len(sent))
+	for _, id := range sent {
+		alreadySent[id] = true
+	}
+
+	// Сбой отправки одному получателю не мешает остальным; повторная публикация
+	// события отправит письма только тем, кому они ещё не ушли
+	var firstErr error
+	for _, recipient := range recipients {
+		if recipient.Email == "" || alreadySent[recipient.ID] {
+			continue
+		}
+		enabled, err := EmailEnabled(db, recipient.ID, event.Type)
+		if err != nil {
+			return err
+		}
+		if !enabled {
+			continue
+		}
+
+		data.RecipientName = displayName(recipient)
+		subject, body, err := Render(recipient.Language, event.Type, data)
+		if err != nil {
+			return err
+		}
+		if err := s.Sender.Send(ctx, Message{To: recipient.Email, Subject: subject, Body: body}); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("отправка письма %s: %w", recipient.Username, err)
+			}
+			continue
+		}
+		delivery := models.EmailDelivery{UserID: recipient.ID, DedupKey: event.DedupKey}
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&delivery).Error; err != nil {
+			return err
+		}
+	}
+	return firstErr
+}
+
+// Recipients возвращает пользователей, которых касается событие: о новом предложении узнают
+// ответственные за тендер, об изменении и закрытии тендера — все участники, подавшие предложения,
+// об остальных событиях предложения — представители его автора.
+func Recipients(db *gorm.DB, eventType events.Type, tender models.Tender, bid *models.Bid) ([]models.User, error) {
+	var users []models.User
+
+	switch {
+	case bid == nil:
+		bidAuthors := func(authorType models.BidAuthorType) *gorm.DB {
+			return db.Model(&models.Bid{}).Select("author_id").Where("tender_id = ? AND author_type = ?", tender.ID, authorType)
+		}
+		err := db.Where("id IN (?)", db.Model(&models.OrganizationResponsible{}).
+			Select("user_id").
+			Where("organization_id IN (?)", bidAuthors(models.BidAuthorTypeOrganization))).
+			Or("id IN (?)", bidAuthors(models.BidAuthorTypeUser)).
+			Find(&users).Error
+		return users, err
+	case eventType == events.BidCreated:
+		return organizationResponsibles(db, tender.OrganizationID)
+	case bid.AuthorType == models.BidAuthorTypeUser:
+		err := db.Where("id = ?", bid.AuthorID).Find(&users).Error
+		return users, err
+	default:
+		return organizationResponsibles(db, bid.AuthorID)
+	}
+}
+
+func organizationResponsibles(db *gorm.DB, organizationID uuid.UUID) ([]models.User, error) {
+	var users []models.User
+	err := db.Where("id IN (?)", db.Model(&models.OrganizationResponsible{}).
+		Select("user_id").
+		Where("organization_id = ?", organizationID)).
+		Find(&users).Error
+	return users, err
+}
+
+// EmailEnabled проверяет, не отключил ли пользователь письма о событии.
+func EmailEnabled(db *gorm.DB, userID uuid.UUID, eventType events.Type) (bool, error) {
+	var preferences []models.NotificationPreference
+	if err := db.Where("user_id = ? AND event_type = ?", userID, string(eventType)).Limit(1).Find(&preferences).Error; err != nil {
+		return false, err
+	}
+	if len(preferences) == 0 {
+		return true, nil
+	}
+	return preferences[0].EmailEnabled, nil
+}
+
+// IsEmailEvent проверяет, отправляются ли письма о событии.
+func IsEmailEvent(eventType events.Type) bool {
+	for _, t := range EmailEvents {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeData приводит данные события, прочитанные из outbox, к нужной структуре.
+func decodeData(data interface{}, target interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, target)
+}
+
+func displayName(user models.User) string {
+	if user.FirstName != "" {
+		return user.FirstName
+	}
+	return user.Username
+}
@@ -0,0 +1,89 @@
+package notifications
+
+import (
+	"context"
+	"tender_management_api/internal/dto"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// InboxSink создаёт уведомления во внутреннем почтовом ящике пользователей, которых касается событие.
+type InboxSink struct {
+	DB *gorm.DB
+}
+
+func (InboxSink) Name() string {
+	return "inbox"
+}
+
+func (s InboxSink) Publish(ctx context.Context, event events.Event) error {
+	if !IsInboxEvent(event.Type) {
+		return nil
+	}
+	db := s.DB.WithContext(ctx)
+
+	var tender models.Tender
+	if err := db.Where("id = ?", event.TenderID).First(&tender).Error; err != nil {
+		return err
+	}
+	data := TemplateData{TenderName: tender.Name}
+
+	var bid *models.Bid
+	if event.BidID != nil {
+		bid = &models.Bid{}
+		if err := db.Where("id = ?", *event.BidID).First(bid).Error; err != nil {
+			return err
+		}
+		data.BidName = bid.Name
+		data.Status = string(bid.Status)
+	}
+	if event.Type == events.BidFeedback {
+		var feedback dto.BidReview
+		if err := decodeData(event.Data, &feedback); err != nil {
+			return err
+		}
+		data.Feedback = feedback.Description
+	}
+
+	recipients, err := Recipients(db, event.Type, tender, bid)
+	if err != nil {
+		return err
+	}
+
+	notifications := make([]models.Notification, 0, len(recipients))
+	for _, recipient := range recipients {
+		data.RecipientName = displayName(recipient)
+		title, body, err := Render(recipient.Language, event.Type, data)
+		if err != nil {
+			return err
+		}
+		notifications = append(notifications, models.Notification{
+			UserID:    recipient.ID,
+			DedupKey:  event.DedupKey,
+			EventType: string(event.Type),
+			TenderID:  event.TenderID,
+			BidID:     event.BidID,
+			Title:     title,
+			Body:      body,
+		})
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	// Повторно опубликованное событие не создаёт дублей уведомлений
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&notifications).Error
+}
+
+// IsInboxEvent проверяет, создаются ли уведомления о событии во внутреннем почтовом ящике.
+func IsInboxEvent(eventType events.Type) bool {
+	for _, t := range InboxEvents {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package notifications
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mockSMTPServer — минимальный SMTP-сервер для тестов: принимает одно письмо на соединение
+// и передаёт его текст в канал messages.
+type mockSMTPServer struct {
+	listener net.Listener
+	messages chan string
+	// silent — сервер принимает соединение, но ничего не отвечает.
+	silent bool
+}
+
+func startMockSMTPServer(t *testing.T, silent bool) *mockSMTPServer {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("запуск тестового SMTP-сервера: %v", err)
+	}
+	server := &mockSMTPServer{listener: listener, messages: make(chan string, 1), silent: silent}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (s *mockSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	if s.silent {
+		// Соединение держится открытым, пока его не закроет клиент
+		_, _ = conn.Read(make([]byte, 1))
+		return
+	}
+
+	text := textproto.NewConn(conn)
+	_ = text.PrintfLine("220 mock ESMTP")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch command {
+		case "EHLO", "HELO":
+			_ = text.PrintfLine("250 mock")
+		case "MAIL", "RCPT", "RSET", "NOOP":
+			_ = text.PrintfLine("250 OK")
+		case "DATA":
+			_ = text.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			lines, err := text.ReadDotLines()
+			if err != nil {
+				return
+			}
+			s.messages <- strings.Join(lines, "\n")
+			_ = text.PrintfLine("250 OK")
+		case "QUIT":
+			_ = text.PrintfLine("221 Bye")
+			return
+		default:
+			_ = text.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+func TestSMTPSenderSend(t *testing.T) {
+	server := startMockSMTPServer(t, false)
+	sender := SMTPSender{Address: server.listener.Addr().String(), From: "tenders@example.com", Timeout: 5 * time.Second}
+
+	err := sender.Send(context.Background(), Message{To: "user@example.com", Subject: "Предложение одобрено", Body: "Строка 1\nСтрока 2"})
+	if err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+
+	select {
+	case message := <-server.messages:
+		for _, want := range []string{"From: tenders@example.com", "To: user@example.com", "Subject: =?utf-8?q?", "Строка 1\nСтрока 2"} {
+			if !strings.Contains(message, want) {
+				t.Errorf("в письме нет %q:\n%s", want, message)
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("сервер не получил письмо")
+	}
+}
+
+func TestSMTPSenderTimeout(t *testing.T) {
+	server := startMockSMTPServer(t, true)
+	sender := SMTPSender{Address: server.listener.Addr().String(), From: "tenders@example.com", Timeout: 200 * time.Millisecond}
+
+	started := time.Now()
+	err := sender.Send(context.Background(), Message{To: "user@example.com", Subject: "Тема", Body: "Текст"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ожидалась ошибка истечения срока, получено: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Fatalf("отправка заняла %s при сроке 200ms", elapsed)
+	}
+}
+
+func TestSMTPSenderCancel(t *testing.T) {
+	server := startMockSMTPServer(t, true)
+	sender := SMTPSender{Address: server.listener.Addr().String(), From: "tenders@example.com", Timeout: time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	err := sender.Send(ctx, Message{To: "user@example.com", Subject: "Тема", Body: "Текст"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ожидалась ошибка отмены, получено: %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package notifications
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"tender_management_api/internal/events"
+	"text/template"
+)
+
+// Языки шаблонов. Русский используется по умолчанию.
+const (
+	LanguageRussian = "ru"
+	LanguageEnglish = "en"
+)
+
+// EmailEvents — события, о которых отправляются письма.
+var EmailEvents = []events.Type{
+	events.BidCreated,
+	events.BidApproved,
+	events.BidRejected,
+	events.BidFeedback,
+}
+
+// InboxEvents — события, о которых создаются уведомления во внутреннем почтовом ящике.
+var InboxEvents = []events.Type{
+	events.BidStatusChanged,
+	events.BidPublished,
+	events.BidCanceled,
+	events.BidApproved,
+	events.BidRejected,
+	events.BidFeedback,
+	events.TenderEdited,
+	events.TenderRolledBack,
+	events.TenderClosed,
+}
+
+//go:embed templates
+var templateFiles embed.FS
+
+// templates хранит шаблоны писем по языку и типу события.
+var templates = mustLoadTemplates()
+
+func mustLoadTemplates() map[string]map[events.Type]*template.Template {
+	loaded := make(map[string]map[events.Type]*template.Template)
+	for _, language := range []string{LanguageRussian, LanguageEnglish} {
+		loaded[language] = make(map[events.Type]*template.Template)
+		for _, eventType := range append(append([]events.Type{}, EmailEvents...), InboxEvents...) {
+			if _, ok := loaded[language][eventType]; ok {
+				continue
+			}
+			path := fmt.Sprintf("templates/%s/%s.tmpl", language, eventType)
+			loaded[language][eventType] = template.Must(template.ParseFS(templateFiles, path))
+		}
+	}
+	return loaded
+}
+
+// TemplateData — данные, доступные в шаблонах писем.
+type TemplateData struct {
+	RecipientName string
+	TenderName    string
+	BidName       string
+	Status        string
+	Feedback      string
+}
+
+// Render формирует тему и текст уведомления на языке получателя.
+func Render(language string, eventType events.Type, data TemplateData) (subject, body string, err error) {
+	byType, ok := templates[language]
+	if !ok {
+		byType = templates[LanguageRussian]
+	}
+	tmpl, ok := byType[eventType]
+	if !ok {
+		return "", "", fmt.Errorf("нет шаблона уведомления для события %s", eventType)
+	}
+
+	var subjectBuf, bodyBuf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subjectBuf, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&bodyBuf, "body", data); err != nil {
+		return "", "", err
+	}
+	return strings.TrimSpace(subjectBuf.String()), bodyBuf.String(), nil
+}
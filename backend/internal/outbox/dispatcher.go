@@ -0,0 +1,164 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"tender_management_api/internal/health"
+	"tender_management_api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	pollInterval   = 500 * time.Millisecond
+	batchSize      = 50
+	baseRetryDelay = time.Second
+	maxRetryDelay  = 5 * time.Minute
+	claimLease     = time.Minute
+)
+
+// Dispatcher публикует ожидающие сообщения outbox во все получатели.
+type Dispatcher struct {
+	db    *gorm.DB
+	sinks []Sink
+	state *health.State
+}
+
+func NewDispatcher(db *gorm.DB, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{db: db, sinks: sinks, state: health.Default}
+}
+
+// Run публикует сообщения, пока не будет отменён контекст.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	// Каждый проход отмечается в состоянии готовности, чтобы зависший публикатор был заметен
+	d.state.Set(health.ComponentOutbox, nil)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := d.dispatchBatch(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Ошибка публикации событий outbox", "error", err)
+			}
+			d.state.Set(health.ComponentOutbox, err)
+		}
+	}
+}
+
+// claim забирает пачку сообщений, срок публикации которых наступил. Срок забранных сообщений сдвигается,
+// поэтому другие экземпляры сервиса не возьмут их, пока идёт публикация, а блокировки строк
+// снимаются до обращения к получателям.
+func (d *Dispatcher) claim() ([]models.OutboxMessage, error) {
+	var messages []models.OutboxMessage
+	err := d.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND available_at <= ?", models.OutboxStatusPending, time.Now()).
+			Order("sequence ASC").
+			Limit(batchSize).
+			Find(&messages).Error
+		if err != nil || len(messages) == 0 {
+			return err
+		}
+
+		sequences := make([]int64, 0, len(messages))
+		for _, message := range messages {
+			sequences = append(sequences, message.Sequence)
+		}
+		return tx.Model(&models.OutboxMessage{}).
+			Where("sequence IN ?", sequences).
+			Update("available_at", time.Now().Add(claimLease)).Error
+	})
+	return messages, err
+}
+
+// dispatchBatch публикует забранные сообщения по порядку.
+func (d *Dispatcher) dispatchBatch(ctx context.Context) error {
+	messages, err := d.claim()
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		updates := map[string]interface{}{"attempts": message.Attempts + 1}
+		if publishErr := d.publish(ctx, message); publishErr != nil {
+			updates["last_error"] = truncate(publishErr.Error(), 1000)
+			updates["available_at"] = time.Now().Add(retryDelay(message.Attempts + 1))
+		} else {
+			now := time.Now()
+			updates["status"] = models.OutboxStatusPublished
+			updates["published_at"] = &now
+			updates["last_error"] = ""
+		}
+
+		if err := d.db.Model(&models.OutboxMessage{}).Where("sequence = ?", message.Sequence).Updates(updates).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publish передаёт сообщение получателям, которые его ещё не получили, и отмечает каждую успешную передачу.
+// Сбой одного получателя не мешает остальным; возвращается первая ошибка.
+func (d *Dispatcher) publish(ctx context.Context, message models.OutboxMessage) error {
+	event, err := Decode(message)
+	if err != nil {
+		return fmt.Errorf("некорректное сообщение: %w", err)
+	}
+
+	var delivered []string
+	if err := d.db.Model(&models.OutboxDelivery{}).Where("message_sequence = ?", message.Sequence).Pluck("sink", &delivered).Error; err != nil {
+		return err
+	}
+	done := make(map[string]bool, len(delivered))
+	for _, name := range delivered {
+		done[name] = true
+	}
+
+	var firstErr error
+	for _, sink := range d.sinks {
+		if done[sink.Name()] {
+			continue
+		}
+		if err := sink.Publish(ctx, event); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("%s: %w", sink.Name(), err)
+			}
+			continue
+		}
+		delivery := models.OutboxDelivery{MessageSequence: message.Sequence, Sink: sink.Name()}
+		if err := d.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&delivery).Error; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func retryDelay(attempt int) time.Duration {
+	delay := baseRetryDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,79 @@
+package outbox
+
+import (
+	"encoding/json"
+	"strings"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Enqueue сохраняет события в outbox. Вызывается в той же транзакции, что и изменение,
+// поэтому событие публикуется тогда и только тогда, когда изменение зафиксировано.
+func Enqueue(tx *gorm.DB, list ...events.Event) error {
+	if len(list) == 0 {
+		return nil
+	}
+
+	messages := make([]models.OutboxMessage, 0, len(list))
+	for _, event := range list {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+
+		organizationIDs := make([]string, 0, len(event.OrganizationIDs))
+		for _, id := range event.OrganizationIDs {
+			organizationIDs = append(organizationIDs, id.String())
+		}
+
+		messages = append(messages, models.OutboxMessage{
+			DedupKey:        DedupKey(event),
+			EventID:         event.ID,
+			EventType:       string(event.Type),
+			TenderID:        event.TenderID,
+			BidID:           event.BidID,
+			OrganizationIDs: strings.Join(organizationIDs, ","),
+			Payload:         models.JSONText(payload),
+			Status:          models.OutboxStatusPending,
+			AvailableAt:     time.Now(),
+		})
+	}
+
+	// Повторная запись того же события игнорируется
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&messages).Error
+}
+
+// DedupKey возвращает ключ, по которому получатели отбрасывают повторные доставки события.
+func DedupKey(event events.Event) string {
+	if event.DedupKey != "" {
+		return event.DedupKey
+	}
+	return string(event.Type) + ":" + event.ID.String()
+}
+
+// Decode восстанавливает событие из сообщения outbox.
+func Decode(message models.OutboxMessage) (events.Event, error) {
+	var event events.Event
+	if err := json.Unmarshal([]byte(message.Payload), &event); err != nil {
+		return event, err
+	}
+
+	event.Sequence = message.Sequence
+	event.DedupKey = message.DedupKey
+	event.OrganizationIDs = nil
+	if message.OrganizationIDs != "" {
+		for _, value := range strings.Split(message.OrganizationIDs, ",") {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				return event, err
+			}
+			event.OrganizationIDs = append(event.OrganizationIDs, id)
+		}
+	}
+	return event, nil
+}
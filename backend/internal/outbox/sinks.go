@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"tender_management_api/internal/events"
+)
+
+// Sink — получатель событий из outbox. Доставка отслеживается для каждого получателя отдельно:
+// при сбое событие передаётся повторно только тем, кто его ещё не получил. Доставка всё равно
+// выполняется по схеме at-least-once (сбой возможен между передачей и отметкой о ней),
+// поэтому получатели должны отбрасывать повторы по Event.DedupKey.
+// Name служит ключом отметки о доставке и не должен меняться между версиями.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, event events.Event) error
+}
+
+// LogSink записывает события в лог сервиса.
+type LogSink struct{}
+
+func (LogSink) Name() string {
+	return "log"
+}
+
+func (LogSink) Publish(ctx context.Context, event events.Event) error {
+	slog.InfoContext(ctx, "Событие",
+		"dedup_key", event.DedupKey, "sequence", event.Sequence, "type", event.Type, "tender_id", event.TenderID)
+	return nil
+}
+
+// BusSink передаёт события во внутреннюю шину процесса. Шина не выходит за пределы процесса:
+// при нескольких экземплярах сервиса событие получают только подписчики экземпляра, который
+// опубликовал сообщение. Подписчики остальных экземпляров получают его при повторном
+// подключении из истории outbox (см. Last-Event-ID в потоке событий тендера).
+type BusSink struct {
+	Bus *events.Bus
+}
+
+func (BusSink) Name() string {
+	return "bus"
+}
+
+func (s BusSink) Publish(_ context.Context, event events.Event) error {
+	s.Bus.Publish(event)
+	return nil
+}
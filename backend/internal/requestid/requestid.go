@@ -0,0 +1,33 @@
+package requestid
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Header — заголовок, в котором идентификатор запроса приходит от клиента и возвращается в ответе.
+const Header = "X-Request-ID"
+
+const contextKey = "requestId"
+
+// valid — допустимый идентификатор от клиента. Остальные заменяются новым, чтобы в журналы
+// не попадали произвольные строки.
+var valid = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// Ensure берёт идентификатор из заголовка запроса или создаёт новый, сохраняет его
+// в контексте и возвращает.
+func Ensure(c *gin.Context) string {
+	id := c.GetHeader(Header)
+	if !valid.MatchString(id) {
+		id = uuid.NewString()
+	}
+	c.Set(contextKey, id)
+	return id
+}
+
+// FromContext возвращает идентификатор текущего запроса или пустую строку, если он не назначен.
+func FromContext(c *gin.Context) string {
+	return c.GetString(contextKey)
+}
@@ -0,0 +1,12 @@
+package routers
+
+import (
+	"tender_management_api/internal/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func InitAuditRoutes(router *gin.RouterGroup) {
+	router.GET("/audit", controllers.GetAuditEvents)
+	router.GET("/tenders/:tenderId/audit/verify", controllers.VerifyTenderAuditChain)
+}
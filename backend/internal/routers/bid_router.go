@@ -9,12 +9,22 @@ import (
 func InitBidRoutes(router *gin.RouterGroup) {
 	router.POST("/bids/new", controllers.CreateBid)
 	router.GET("/bids/my", controllers.GetUserBids)
-	router.GET("/bids/:tenderId/list", controllers.GetBidsForTender)
+	router.GET("/bids/:bidId/list", tenderIDFromBidID(controllers.GetBidsForTender))
 	router.GET("/bids/:bidId/status", controllers.GetBidStatus)
 	router.PUT("/bids/:bidId/status", controllers.UpdateBidStatus)
 	router.PATCH("/bids/:bidId/edit", controllers.EditBid)
 	router.PUT("/bids/:bidId/rollback/:version", controllers.RollbackBid)
 	router.PUT("/bids/:bidId/submit_decision", controllers.SubmitBidDecision)
 	router.PUT("/bids/:bidId/feedback", controllers.SubmitBidFeedback)
-	router.GET("/bids/:tenderId/reviews", controllers.GetBidReviews)
+	router.GET("/bids/:bidId/reviews", tenderIDFromBidID(controllers.GetBidReviews))
+}
+
+// tenderIDFromBidID передаёт обработчику первый сегмент пути как параметр tenderId.
+// Gin не допускает разные имена параметров в одной позиции пути, поэтому маршруты
+// /bids/{tenderId}/... регистрируются с тем же именем :bidId, что и остальные.
+func tenderIDFromBidID(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Params = append(c.Params, gin.Param{Key: "tenderId", Value: c.Param("bidId")})
+		handler(c)
+	}
 }
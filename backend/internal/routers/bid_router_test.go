@@ -0,0 +1,38 @@
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+// TestTenderIDFromBidID проверяет, что маршруты /bids/{tenderId}/list и /bids/{tenderId}/reviews,
+// зарегистрированные с параметром :bidId, передают обработчику идентификатор тендера как tenderId,
+// а соседние маршруты по-прежнему получают bidId.
+func TestTenderIDFromBidID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var tenderID, bidID string
+	router := gin.New()
+	router.GET("/bids/:bidId/list", tenderIDFromBidID(func(c *gin.Context) {
+		tenderID = c.Param("tenderId")
+		c.Status(http.StatusOK)
+	}))
+	router.GET("/bids/:bidId/status", func(c *gin.Context) {
+		bidID = c.Param("bidId")
+		c.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/bids/tender-1/list", "/bids/bid-1/status"} {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("%s: статус %d", path, recorder.Code)
+		}
+	}
+	if tenderID != "tender-1" || bidID != "bid-1" {
+		t.Errorf("tenderId = %q, bidId = %q", tenderID, bidID)
+	}
+}
@@ -0,0 +1,564 @@
+package routers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/config"
+	"tender_management_api/internal/database"
+	"tender_management_api/internal/middlewares"
+	"tender_management_api/internal/models"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// Контрактные тесты поднимают маршрутизатор на тестовом HTTP-сервере поверх одноразовой схемы
+// PostgreSQL и проверяют каждую операцию спецификации: коды ответов и их схемы.
+// Строка подключения берётся из TEST_POSTGRES_CONN. Локально без неё тесты пропускаются,
+// а в CI (задана переменная CI) падают, чтобы набор не проходил молча пустым.
+
+const contractDatabaseEnv = "TEST_POSTGRES_CONN"
+
+// contractJWTSecret — секрет, которым тесты подписывают токены.
+const contractJWTSecret = "contract_secret"
+
+// specPath — путь к спецификации относительно каталога пакета.
+var specPath = filepath.Join("..", "..", "..", "задание", "openapi.yml")
+
+// authMode задаёт заголовок Authorization запроса.
+type authMode int
+
+const (
+	authValid authMode = iota
+	authNone
+	authMalformed
+	authInvalid
+)
+
+// contractRequest — запрос к API и ожидаемый результат.
+type contractRequest struct {
+	operationID string
+	method      string
+	path        string
+	query       url.Values
+	body        interface{}
+	auth        authMode
+
+	status int
+	// code — ожидаемый код ошибки каталога для ответов 4xx
+	code apierrors.Code
+}
+
+// contractHarness — тестовый сервер, спецификация и засеянные данные.
+type contractHarness struct {
+	server *httptest.Server
+	token  string
+
+	// operations — операции спецификации по operationId; covered отмечает проверенные
+	operations map[string]*routers.Route
+	covered    map[string]bool
+
+	tenderOrg, bidderOrg models.Organization
+	// tenderOwner отвечает за организацию тендера, bidder — за организацию-участника,
+	// outsider не связан ни с одной организацией
+	tenderOwner, bidder, outsider models.User
+}
+
+func newContractHarness(t *testing.T) *contractHarness {
+	t.Helper()
+
+	conn := os.Getenv(contractDatabaseEnv)
+	if conn == "" {
+		if os.Getenv("CI") != "" {
+			t.Fatalf("%s не задана: в CI контрактные тесты должны выполняться на PostgreSQL", contractDatabaseEnv)
+		}
+		t.Skipf("%s не задана, контрактные тесты пропущены", contractDatabaseEnv)
+	}
+
+	spec, err := middlewares.LoadOpenAPISpec(specPath)
+	if err != nil {
+		t.Fatalf("загрузка спецификации: %v", err)
+	}
+
+	connectThrowawaySchema(t, conn)
+
+	gin.SetMode(gin.TestMode)
+	h := &contractHarness{
+		token:      signToken(t),
+		operations: make(map[string]*routers.Route),
+		covered:    make(map[string]bool),
+	}
+	h.server = httptest.NewServer(NewRouter(&config.Config{JWTSecret: contractJWTSecret}, spec))
+	t.Cleanup(h.server.Close)
+
+	for path, item := range spec.Paths.Map() {
+		for method, operation := range item.Operations() {
+			h.operations[operation.OperationID] = &routers.Route{
+				Spec:      spec,
+				Path:      path,
+				PathItem:  item,
+				Method:    method,
+				Operation: operation,
+			}
+		}
+	}
+
+	h.seed(t)
+	return h
+}
+
+// connectThrowawaySchema создаёт отдельную схему, выполняет в ней миграции и удаляет её по окончании тестов.
+func connectThrowawaySchema(t *testing.T, conn string) {
+	t.Helper()
+
+	admin, err := gorm.Open(postgres.Open(conn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("подключение к тестовой базе: %v", err)
+	}
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		t.Fatal(err)
+	}
+	schema := "contract_" + hex.EncodeToString(suffix)
+
+	if err := admin.Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`).Error; err != nil {
+		t.Fatalf("создание расширения uuid-ossp: %v", err)
+	}
+	if err := admin.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("создание схемы %s: %v", schema, err)
+	}
+	t.Cleanup(func() {
+		if database.DB != nil {
+			if sqlDB, err := database.DB.DB(); err == nil {
+				sqlDB.Close()
+			}
+		}
+		if err := admin.Exec("DROP SCHEMA " + schema + " CASCADE").Error; err != nil {
+			t.Errorf("удаление схемы %s: %v", schema, err)
+		}
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+
+	err = database.ConnectDatabase(context.Background(), &config.Config{PostgresConn: withSearchPath(t, conn, schema)})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// withSearchPath добавляет в строку подключения search_path: сначала тестовая схема,
+// затем public, где установлено расширение uuid-ossp.
+func withSearchPath(t *testing.T, conn, schema string) string {
+	t.Helper()
+
+	searchPath := schema + ",public"
+	if !strings.Contains(conn, "://") {
+		return conn + " search_path=" + searchPath
+	}
+	u, err := url.Parse(conn)
+	if err != nil {
+		t.Fatalf("разбор %s: %v", contractDatabaseEnv, err)
+	}
+	query := u.Query()
+	query.Set("search_path", searchPath)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// signToken выпускает токен, который принимает AuthMiddleware.
+func signToken(t *testing.T) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	signed, err := token.SignedString([]byte(contractJWTSecret))
+	if err != nil {
+		t.Fatalf("подпись токена: %v", err)
+	}
+	return signed
+}
+
+// seed создаёт две организации с ответственными и пользователя без организации.
+func (h *contractHarness) seed(t *testing.T) {
+	t.Helper()
+
+	h.tenderOrg = models.Organization{Name: "Заказчик", Type: models.LLC}
+	h.bidderOrg = models.Organization{Name: "Исполнитель", Type: models.JSC}
+	h.tenderOwner = models.User{Username: "contract_owner", FirstName: "Ирина"}
+	h.bidder = models.User{Username: "contract_bidder", FirstName: "Пётр"}
+	h.outsider = models.User{Username: "contract_outsider", FirstName: "Олег"}
+
+	for _, record := range []interface{}{&h.tenderOrg, &h.bidderOrg, &h.tenderOwner, &h.bidder, &h.outsider} {
+		if err := database.DB.Create(record).Error; err != nil {
+			t.Fatalf("заполнение базы: %v", err)
+		}
+	}
+	responsibles := []models.OrganizationResponsible{
+		{OrganizationID: h.tenderOrg.ID, UserID: h.tenderOwner.ID},
+		{OrganizationID: h.bidderOrg.ID, UserID: h.bidder.ID},
+	}
+	if err := database.DB.Create(&responsibles).Error; err != nil {
+		t.Fatalf("заполнение базы: %v", err)
+	}
+}
+
+// seedUserBid создаёт предложение, автор которого — пользователь bidder, вместе с его первой версией
+// и возвращает идентификатор предложения.
+func (h *contractHarness) seedUserBid(t *testing.T, tenderID string) string {
+	t.Helper()
+
+	bid := models.Bid{
+		Name:        "Доставка силами частного перевозчика",
+		Description: "Один автомобиль, без страховки",
+		Status:      models.BidStatusCreated,
+		TenderID:    uuid.MustParse(tenderID),
+		AuthorType:  models.BidAuthorTypeUser,
+		AuthorID:    h.bidder.ID,
+		Version:     1,
+	}
+	if err := database.DB.Create(&bid).Error; err != nil {
+		t.Fatalf("заполнение базы: %v", err)
+	}
+	version := models.BidVersion{BidID: bid.ID, Version: 1, Name: bid.Name, Description: bid.Description, CreatedBy: h.bidder.ID}
+	if err := database.DB.Create(&version).Error; err != nil {
+		t.Fatalf("заполнение базы: %v", err)
+	}
+	return bid.ID.String()
+}
+
+// do выполняет запрос, проверяет код ответа и его соответствие спецификации и возвращает тело.
+func (h *contractHarness) do(t *testing.T, req contractRequest) []byte {
+	t.Helper()
+
+	route, ok := h.operations[req.operationID]
+	if !ok {
+		t.Fatalf("операция %s не описана в спецификации", req.operationID)
+	}
+	h.covered[req.operationID] = true
+
+	var body io.Reader
+	if req.body != nil {
+		data, err := json.Marshal(req.body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body = bytes.NewReader(data)
+	}
+	target := h.server.URL + "/api" + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+	httpReq, err := http.NewRequest(req.method, target, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	switch req.auth {
+	case authValid:
+		httpReq.Header.Set("Authorization", "Bearer "+h.token)
+	case authMalformed:
+		httpReq.Header.Set("Authorization", h.token)
+	case authInvalid:
+		httpReq.Header.Set("Authorization", "Bearer "+h.token+"x")
+	}
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("%s %s: %v", req.method, req.path, err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.StatusCode != req.status {
+		t.Fatalf("%s %s: код ответа %d, ожидался %d: %s", req.method, req.path, resp.StatusCode, req.status, respBody)
+	}
+	if req.status >= http.StatusBadRequest {
+		h.checkProblem(t, req, resp, respBody)
+	}
+
+	// Ответы об ошибках — application/problem+json, JSON-совместимое расширение errorResponse.
+	// Коды, которых нет в описании операции, сверяются только с каталогом ошибок.
+	header := resp.Header.Clone()
+	if strings.HasPrefix(header.Get("Content-Type"), apierrors.ContentType) {
+		header.Set("Content-Type", "application/json")
+	}
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request: httpReq,
+			Route:   route,
+		},
+		Status: resp.StatusCode,
+		Header: header,
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: false,
+			MultiError:            true,
+		},
+	}
+	input.SetBodyBytes(respBody)
+	if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+		t.Errorf("%s %s: ответ не соответствует спецификации: %v\n%s", req.method, req.path, err, respBody)
+	}
+	return respBody
+}
+
+// checkProblem проверяет тело ответа об ошибке.
+func (h *contractHarness) checkProblem(t *testing.T, req contractRequest, resp *http.Response, body []byte) {
+	t.Helper()
+
+	if contentType := resp.Header.Get("Content-Type"); !strings.HasPrefix(contentType, apierrors.ContentType) {
+		t.Errorf("%s %s: Content-Type %q, ожидался %s", req.method, req.path, contentType, apierrors.ContentType)
+	}
+	var problem apierrors.Problem
+	if err := json.Unmarshal(body, &problem); err != nil {
+		t.Fatalf("%s %s: разбор ошибки: %v", req.method, req.path, err)
+	}
+	if problem.Status != req.status {
+		t.Errorf("%s %s: status в теле %d, ожидался %d", req.method, req.path, problem.Status, req.status)
+	}
+	if req.code != "" && problem.Code != req.code {
+		t.Errorf("%s %s: код ошибки %s, ожидался %s", req.method, req.path, problem.Code, req.code)
+	}
+}
+
+// decodeID возвращает идентификатор объекта из тела ответа.
+func decodeID(t *testing.T, body []byte) string {
+	t.Helper()
+
+	var object struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &object); err != nil || object.ID == "" {
+		t.Fatalf("в ответе нет id: %s", body)
+	}
+	return object.ID
+}
+
+// params собирает параметры запроса из пар ключ-значение.
+func params(pairs ...string) url.Values {
+	values := url.Values{}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		values.Set(pairs[i], pairs[i+1])
+	}
+	return values
+}
+
+func TestContract(t *testing.T) {
+	h := newContractHarness(t)
+	owner, bidder, outsider := h.tenderOwner.Username, h.bidder.Username, h.outsider.Username
+	missingID := uuid.NewString()
+
+	h.do(t, contractRequest{operationID: "checkServer", method: http.MethodGet, path: "/ping", auth: authNone, status: http.StatusOK})
+
+	// Авторизация
+	h.do(t, contractRequest{operationID: "getTenders", method: http.MethodGet, path: "/tenders", auth: authNone,
+		status: http.StatusUnauthorized, code: apierrors.CodeTokenMissing})
+	h.do(t, contractRequest{operationID: "getTenders", method: http.MethodGet, path: "/tenders", auth: authMalformed,
+		status: http.StatusUnauthorized, code: apierrors.CodeTokenMalformed})
+	h.do(t, contractRequest{operationID: "getTenders", method: http.MethodGet, path: "/tenders", auth: authInvalid,
+		status: http.StatusUnauthorized, code: apierrors.CodeTokenInvalid})
+
+	// Тендеры
+	newTender := map[string]interface{}{
+		"name":            "Доставка товаров Казань - Москва",
+		"description":     "Нужно доставить оборудование для олимпиады по робототехнике",
+		"serviceType":     "Delivery",
+		"status":          "Created",
+		"organizationId":  h.tenderOrg.ID,
+		"creatorUsername": owner,
+	}
+	tenderID := decodeID(t, h.do(t, contractRequest{operationID: "createTender", method: http.MethodPost, path: "/tenders/new",
+		body: newTender, status: http.StatusOK}))
+
+	invalidTender := map[string]interface{}{"name": "Без описания", "serviceType": "Delivery", "status": "Created",
+		"organizationId": h.tenderOrg.ID, "creatorUsername": owner}
+	h.do(t, contractRequest{operationID: "createTender", method: http.MethodPost, path: "/tenders/new",
+		body: invalidTender, status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+	unknownCreator := map[string]interface{}{}
+	for key, value := range newTender {
+		unknownCreator[key] = value
+	}
+	unknownCreator["creatorUsername"] = "contract_nobody"
+	h.do(t, contractRequest{operationID: "createTender", method: http.MethodPost, path: "/tenders/new",
+		body: unknownCreator, status: http.StatusUnauthorized, code: apierrors.CodeUserNotFound})
+	notResponsible := map[string]interface{}{}
+	for key, value := range newTender {
+		notResponsible[key] = value
+	}
+	notResponsible["creatorUsername"] = outsider
+	h.do(t, contractRequest{operationID: "createTender", method: http.MethodPost, path: "/tenders/new",
+		body: notResponsible, status: http.StatusForbidden, code: apierrors.CodeForbiddenNotResponsible})
+
+	tenderPath := "/tenders/" + tenderID
+	h.do(t, contractRequest{operationID: "getTenderStatus", method: http.MethodGet, path: tenderPath + "/status",
+		query: params("username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getTenderStatus", method: http.MethodGet, path: "/tenders/" + missingID + "/status",
+		query: params("username", owner), status: http.StatusNotFound, code: apierrors.CodeTenderNotFound})
+	h.do(t, contractRequest{operationID: "getTenderStatus", method: http.MethodGet, path: tenderPath + "/status",
+		query: params("username", "contract_nobody"), status: http.StatusUnauthorized, code: apierrors.CodeUserNotFound})
+
+	h.do(t, contractRequest{operationID: "updateTenderStatus", method: http.MethodPut, path: tenderPath + "/status",
+		query: params("status", "Published", "username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "updateTenderStatus", method: http.MethodPut, path: tenderPath + "/status",
+		query: params("status", "Archived", "username", owner), status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+	h.do(t, contractRequest{operationID: "updateTenderStatus", method: http.MethodPut, path: tenderPath + "/status",
+		query: params("status", "Closed", "username", outsider), status: http.StatusForbidden, code: apierrors.CodeForbiddenNotResponsible})
+
+	h.do(t, contractRequest{operationID: "editTender", method: http.MethodPatch, path: tenderPath + "/edit",
+		query: params("username", owner), body: map[string]interface{}{"description": "Доставка в два этапа"}, status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "editTender", method: http.MethodPatch, path: tenderPath + "/edit",
+		query: params("username", owner), body: map[string]interface{}{"serviceType": "Teleport"}, status: http.StatusBadRequest,
+		code: apierrors.CodeValidationFailed})
+	h.do(t, contractRequest{operationID: "editTender", method: http.MethodPatch, path: tenderPath + "/edit",
+		query: params("username", outsider), body: map[string]interface{}{"name": "Чужой тендер"}, status: http.StatusForbidden,
+		code: apierrors.CodeForbiddenNotResponsible})
+	h.do(t, contractRequest{operationID: "editTender", method: http.MethodPatch, path: "/tenders/" + missingID + "/edit",
+		query: params("username", owner), body: map[string]interface{}{"name": "Нет такого"}, status: http.StatusNotFound,
+		code: apierrors.CodeTenderNotFound})
+
+	h.do(t, contractRequest{operationID: "rollbackTender", method: http.MethodPut, path: tenderPath + "/rollback/1",
+		query: params("username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "rollbackTender", method: http.MethodPut, path: tenderPath + "/rollback/0",
+		query: params("username", owner), status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+	h.do(t, contractRequest{operationID: "rollbackTender", method: http.MethodPut, path: tenderPath + "/rollback/99",
+		query: params("username", owner), status: http.StatusNotFound, code: apierrors.CodeVersionNotFound})
+
+	h.do(t, contractRequest{operationID: "getTenders", method: http.MethodGet, path: "/tenders",
+		query: params("service_type", "Delivery"), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getTenders", method: http.MethodGet, path: "/tenders",
+		query: params("limit", "100"), status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+	h.do(t, contractRequest{operationID: "getUserTenders", method: http.MethodGet, path: "/tenders/my",
+		query: params("username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getUserTenders", method: http.MethodGet, path: "/tenders/my",
+		query: params("username", "contract_nobody"), status: http.StatusUnauthorized, code: apierrors.CodeUserNotFound})
+
+	// Предложения
+	newBid := map[string]interface{}{
+		"name":            "Доставка за три дня",
+		"description":     "Собственный автопарк, страховка груза",
+		"status":          "Created",
+		"tenderId":        tenderID,
+		"organizationId":  h.bidderOrg.ID,
+		"creatorUsername": bidder,
+	}
+	bidID := decodeID(t, h.do(t, contractRequest{operationID: "createBid", method: http.MethodPost, path: "/bids/new",
+		body: newBid, status: http.StatusOK}))
+
+	conflicting := map[string]interface{}{}
+	for key, value := range newBid {
+		conflicting[key] = value
+	}
+	conflicting["organizationId"] = h.tenderOrg.ID
+	conflicting["creatorUsername"] = owner
+	h.do(t, contractRequest{operationID: "createBid", method: http.MethodPost, path: "/bids/new",
+		body: conflicting, status: http.StatusForbidden, code: apierrors.CodeConflictOfInterest})
+	missingTender := map[string]interface{}{}
+	for key, value := range newBid {
+		missingTender[key] = value
+	}
+	missingTender["tenderId"] = missingID
+	h.do(t, contractRequest{operationID: "createBid", method: http.MethodPost, path: "/bids/new",
+		body: missingTender, status: http.StatusNotFound, code: apierrors.CodeTenderNotFound})
+	h.do(t, contractRequest{operationID: "createBid", method: http.MethodPost, path: "/bids/new",
+		body: map[string]interface{}{"name": "Только название"}, status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+
+	bidPath := "/bids/" + bidID
+	h.do(t, contractRequest{operationID: "getBidStatus", method: http.MethodGet, path: bidPath + "/status",
+		query: params("username", bidder), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getBidStatus", method: http.MethodGet, path: "/bids/" + missingID + "/status",
+		query: params("username", bidder), status: http.StatusNotFound, code: apierrors.CodeBidNotFound})
+
+	// Изменять предложение может его автор-пользователь. Через API создаются только предложения
+	// организаций, поэтому предложение пользователя создаётся напрямую в базе.
+	userBidPath := "/bids/" + h.seedUserBid(t, tenderID)
+	h.do(t, contractRequest{operationID: "updateBidStatus", method: http.MethodPut, path: userBidPath + "/status",
+		query: params("status", "Published", "username", bidder), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "updateBidStatus", method: http.MethodPut, path: userBidPath + "/status",
+		query: params("status", "Canceled", "username", outsider), status: http.StatusForbidden, code: apierrors.CodeForbiddenNotResponsible})
+	h.do(t, contractRequest{operationID: "updateBidStatus", method: http.MethodPut, path: userBidPath + "/status",
+		query: params("username", bidder), status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+
+	h.do(t, contractRequest{operationID: "editBid", method: http.MethodPatch, path: userBidPath + "/edit",
+		query: params("username", bidder), body: map[string]interface{}{"description": "Доставка за два дня"}, status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "editBid", method: http.MethodPatch, path: userBidPath + "/edit",
+		query: params("username", owner), body: map[string]interface{}{"name": "Чужое предложение"}, status: http.StatusForbidden,
+		code: apierrors.CodeForbiddenNotResponsible})
+
+	h.do(t, contractRequest{operationID: "rollbackBid", method: http.MethodPut, path: userBidPath + "/rollback/1",
+		query: params("username", bidder), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "rollbackBid", method: http.MethodPut, path: userBidPath + "/rollback/99",
+		query: params("username", bidder), status: http.StatusNotFound, code: apierrors.CodeVersionNotFound})
+
+	// Предложение организации изменяет ответственный за неё, а не только сам автор
+	h.do(t, contractRequest{operationID: "updateBidStatus", method: http.MethodPut, path: bidPath + "/status",
+		query: params("status", "Published", "username", bidder), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "editBid", method: http.MethodPatch, path: bidPath + "/edit",
+		query: params("username", bidder), body: map[string]interface{}{"description": "Доставка за два дня"}, status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "editBid", method: http.MethodPatch, path: bidPath + "/edit",
+		query: params("username", owner), body: map[string]interface{}{"name": "Чужое предложение"}, status: http.StatusForbidden,
+		code: apierrors.CodeForbiddenNotResponsible})
+	h.do(t, contractRequest{operationID: "rollbackBid", method: http.MethodPut, path: bidPath + "/rollback/1",
+		query: params("username", bidder), status: http.StatusOK})
+
+	h.do(t, contractRequest{operationID: "getUserBids", method: http.MethodGet, path: "/bids/my",
+		query: params("username", bidder), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getBidsForTender", method: http.MethodGet, path: "/bids/" + tenderID + "/list",
+		query: params("username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getBidsForTender", method: http.MethodGet, path: "/bids/" + tenderID + "/list",
+		query: params("username", outsider), status: http.StatusForbidden, code: apierrors.CodeForbiddenNotResponsible})
+	h.do(t, contractRequest{operationID: "getBidsForTender", method: http.MethodGet, path: "/bids/" + missingID + "/list",
+		query: params("username", owner), status: http.StatusNotFound, code: apierrors.CodeTenderNotFound})
+
+	h.do(t, contractRequest{operationID: "submitBidFeedback", method: http.MethodPut, path: bidPath + "/feedback",
+		query: params("bidFeedback", "Хорошие сроки", "username", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "submitBidFeedback", method: http.MethodPut, path: bidPath + "/feedback",
+		query: params("bidFeedback", "Отзыв о себе", "username", bidder), status: http.StatusForbidden,
+		code: apierrors.CodeForbiddenNotResponsible})
+
+	h.do(t, contractRequest{operationID: "getBidReviews", method: http.MethodGet, path: "/bids/" + tenderID + "/reviews",
+		query: params("authorUsername", bidder, "requesterUsername", owner), status: http.StatusOK})
+	h.do(t, contractRequest{operationID: "getBidReviews", method: http.MethodGet, path: "/bids/" + tenderID + "/reviews",
+		query: params("authorUsername", bidder, "requesterUsername", "contract_nobody"), status: http.StatusUnauthorized,
+		code: apierrors.CodeRequesterNotFound})
+	h.do(t, contractRequest{operationID: "getBidReviews", method: http.MethodGet, path: "/bids/" + tenderID + "/reviews",
+		query: params("authorUsername", bidder, "requesterUsername", outsider), status: http.StatusForbidden,
+		code: apierrors.CodeForbiddenNotResponsible})
+
+	h.do(t, contractRequest{operationID: "submitBidDecision", method: http.MethodPut, path: bidPath + "/submit_decision",
+		query: params("decision", "Maybe", "username", owner), status: http.StatusBadRequest, code: apierrors.CodeValidationFailed})
+	h.do(t, contractRequest{operationID: "submitBidDecision", method: http.MethodPut, path: bidPath + "/submit_decision",
+		query: params("decision", "Approved", "username", bidder), status: http.StatusForbidden, code: apierrors.CodeForbiddenNotResponsible})
+	h.do(t, contractRequest{operationID: "submitBidDecision", method: http.MethodPut, path: bidPath + "/submit_decision",
+		query: params("decision", "Approved", "username", owner), status: http.StatusOK})
+
+	// Каждая операция спецификации должна быть проверена
+	for operationID := range h.operations {
+		if !h.covered[operationID] {
+			t.Errorf("операция %s не покрыта контрактными тестами", operationID)
+		}
+	}
+}
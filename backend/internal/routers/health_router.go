@@ -0,0 +1,12 @@
+package routers
+
+import (
+	"tender_management_api/internal/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func InitHealthRoutes(router *gin.RouterGroup) {
+	router.GET("/healthz", controllers.Healthz)
+	router.GET("/readyz", controllers.Readyz)
+}
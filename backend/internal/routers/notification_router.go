@@ -0,0 +1,15 @@
+package routers
+
+import (
+	"tender_management_api/internal/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func InitNotificationRoutes(router *gin.RouterGroup) {
+	router.GET("/notifications", controllers.GetNotifications)
+	router.PUT("/notifications/read_all", controllers.MarkAllNotificationsRead)
+	router.PUT("/notifications/:notificationId/read", controllers.MarkNotificationRead)
+	router.GET("/notifications/preferences", controllers.GetNotificationPreferences)
+	router.PUT("/notifications/preferences", controllers.UpdateNotificationPreferences)
+}
@@ -0,0 +1,58 @@
+package routers
+
+import (
+	"net/http"
+	"tender_management_api/internal/config"
+	"tender_management_api/internal/i18n"
+	"tender_management_api/internal/metrics"
+	"tender_management_api/internal/middlewares"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+)
+
+// NewRouter собирает маршрутизатор API. Если передана спецификация OpenAPI,
+// запросы к /api проверяются по ней.
+func NewRouter(cfg *config.Config, spec *openapi3.T) *gin.Engine {
+	// Ошибки валидации ссылаются на поля так, как они названы в JSON
+	i18n.UseJSONFieldNames()
+
+	// Журнал запросов в JSON с идентификатором запроса вместо текстового логгера gin
+	router := gin.New()
+	router.Use(
+		middlewares.RequestID(),
+		middlewares.Logger(),
+		middlewares.Recovery(),
+		middlewares.Metrics(),
+		middlewares.Tracing(),
+	)
+
+	// Маршрут для проверки доступности сервера
+	router.GET("/api/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	// Проверки живости и готовности для оркестратора, вне /api и без аутентификации
+	InitHealthRoutes(&router.RouterGroup)
+
+	// Метрики в формате Prometheus
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	// Группа маршрутов с префиксом /api и middleware для аутентификации
+	api := router.Group("/api")
+	api.Use(middlewares.AuthMiddleware(cfg.JWTSecret))
+
+	// Проверка запросов по спецификации OpenAPI
+	if spec != nil {
+		api.Use(middlewares.OpenAPIValidator(spec, cfg.OpenAPIValidateResponses))
+	}
+
+	// Инициализация маршрутов
+	InitTenderRoutes(api)
+	InitBidRoutes(api)
+	InitAuditRoutes(api)
+	InitWebhookRoutes(api)
+	InitNotificationRoutes(api)
+
+	return router
+}
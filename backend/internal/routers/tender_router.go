@@ -14,4 +14,5 @@ func InitTenderRoutes(router *gin.RouterGroup) {
 	router.PUT("/tenders/:tenderId/status", controllers.UpdateTenderStatus)
 	router.PATCH("/tenders/:tenderId/edit", controllers.EditTender)
 	router.PUT("/tenders/:tenderId/rollback/:version", controllers.RollbackTender)
+	router.GET("/tenders/:tenderId/events", controllers.StreamTenderEvents)
 }
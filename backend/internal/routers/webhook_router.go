@@ -0,0 +1,15 @@
+package routers
+
+import (
+	"tender_management_api/internal/controllers"
+
+	"github.com/gin-gonic/gin"
+)
+
+func InitWebhookRoutes(router *gin.RouterGroup) {
+	router.POST("/webhooks/new", controllers.CreateWebhook)
+	router.GET("/webhooks/my", controllers.GetWebhooks)
+	router.DELETE("/webhooks/:webhookId", controllers.DeleteWebhook)
+	router.GET("/webhooks/:webhookId/deliveries", controllers.GetWebhookDeliveries)
+	router.POST("/webhooks/:webhookId/deliveries/:deliveryId/redeliver", controllers.RedeliverWebhook)
+}
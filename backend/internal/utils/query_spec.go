@@ -0,0 +1,322 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/i18n"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Столбцы, по которым разрешена сортировка списков: значение параметра sort -> столбец.
+var sortColumns = map[string]string{
+	"name":       "name",
+	"created_at": "created_at",
+	"version":    "version",
+}
+
+// paginationParams — параметры пагинации, допустимые для любого списка.
+var paginationParams = []string{"limit", "offset", "cursor"}
+
+// Заголовки ответа со сведениями о пагинации. Курсор следующей страницы передаётся только
+// в заголовке X-Next-Cursor: тело ответа остаётся массивом записей, как в контракте API.
+// Заголовок отсутствует на последней странице.
+const (
+	TotalCountHeader = "X-Total-Count"
+	NextCursorHeader = "X-Next-Cursor"
+)
+
+// ListQuerySpec описывает, какие фильтры и сортировки допускает конкретный список.
+type ListQuerySpec struct {
+	// Statuses — допустимые значения фильтра status.
+	Statuses []string
+	// DefaultStatuses применяются, если фильтр status не передан. Пусто — без фильтра.
+	DefaultStatuses []string
+	// OrganizationColumn — столбец или SQL-выражение для фильтра organization_id. Пусто — фильтр недоступен.
+	OrganizationColumn string
+	// DefaultSort — сортировка, если параметр sort не передан.
+	DefaultSort string
+	// Params — прочие параметры, которые обрабатывает сам обработчик.
+	Params []string
+}
+
+// SortField — поле сортировки.
+type SortField struct {
+	Column string
+	Desc   bool
+}
+
+// ListQuery — разобранные фильтры и сортировка списка.
+type ListQuery struct {
+	Statuses        []string
+	CreatedFrom     *time.Time
+	CreatedTo       *time.Time
+	OrganizationIDs []uuid.UUID
+	Sort            []SortField
+	// SortRequested — сортировка передана клиентом явно.
+	SortRequested bool
+	// Cursor — позиция, после которой продолжается выдача. nil — выдача с начала.
+	Cursor *Cursor
+}
+
+// Cursor — позиция в списке: значения полей сортировки и идентификатор последней выданной записи.
+// Клиент получает его в виде непрозрачной строки и передаёт обратно без изменений.
+type Cursor struct {
+	Sort   string    `json:"s"`
+	Values []string  `json:"v"`
+	ID     uuid.UUID `json:"id"`
+
+	// values — значения Values, приведённые к типам столбцов.
+	values []interface{}
+}
+
+// ParseListQuery разбирает параметры status, created_from, created_to, organization_id и sort.
+// Неизвестные параметры и значения считаются ошибкой, а не игнорируются.
+func ParseListQuery(c *gin.Context, spec ListQuerySpec) (ListQuery, error) {
+	var query ListQuery
+
+	known := map[string]bool{"status": true, "created_from": true, "created_to": true, "sort": true}
+	if spec.OrganizationColumn != "" {
+		known["organization_id"] = true
+	}
+	for _, param := range append(append([]string{}, paginationParams...), spec.Params...) {
+		known[param] = true
+	}
+	for param := range c.Request.URL.Query() {
+		if !known[param] {
+			return query, apierrors.InvalidParameter(i18n.MsgUnknownParameter, param)
+		}
+	}
+
+	// Статусы
+	query.Statuses = splitValues(c.QueryArray("status"))
+	for _, status := range query.Statuses {
+		if !contains(spec.Statuses, status) {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameterValue, "status", status)
+		}
+	}
+	if len(query.Statuses) == 0 {
+		query.Statuses = spec.DefaultStatuses
+	}
+
+	// Период создания
+	if value := c.Query("created_from"); value != "" {
+		createdFrom, err := ParseTimeParam(value, false)
+		if err != nil {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameter, "created_from")
+		}
+		query.CreatedFrom = &createdFrom
+	}
+	if value := c.Query("created_to"); value != "" {
+		createdTo, err := ParseTimeParam(value, true)
+		if err != nil {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameter, "created_to")
+		}
+		query.CreatedTo = &createdTo
+	}
+	if query.CreatedFrom != nil && query.CreatedTo != nil && query.CreatedFrom.After(*query.CreatedTo) {
+		return query, apierrors.InvalidParameter(i18n.MsgInvalidPeriod)
+	}
+
+	// Организации
+	for _, value := range splitValues(c.QueryArray("organization_id")) {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameter, "organization_id")
+		}
+		query.OrganizationIDs = append(query.OrganizationIDs, id)
+	}
+
+	// Сортировка
+	sort := c.Query("sort")
+	query.SortRequested = sort != ""
+	if sort == "" {
+		sort = spec.DefaultSort
+	}
+	for _, value := range splitValues([]string{sort}) {
+		field := SortField{}
+		if strings.HasPrefix(value, "-") {
+			field.Desc = true
+			value = strings.TrimPrefix(value, "-")
+		}
+		column, ok := sortColumns[value]
+		if !ok {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameterValue, "sort", value)
+		}
+		field.Column = column
+		query.Sort = append(query.Sort, field)
+	}
+
+	// Курсор
+	if value := c.Query("cursor"); value != "" {
+		cursor, err := decodeCursor(value)
+		if err != nil || cursor.Sort != query.sortKey() || len(cursor.Values) != len(query.Sort) {
+			return query, apierrors.InvalidParameter(i18n.MsgInvalidParameter, "cursor")
+		}
+		if c.Query("offset") != "" {
+			return query, apierrors.InvalidParameter(i18n.MsgCursorWithOffset)
+		}
+		for i, field := range query.Sort {
+			typed, err := cursorValue(field.Column, cursor.Values[i])
+			if err != nil {
+				return query, apierrors.InvalidParameter(i18n.MsgInvalidParameter, "cursor")
+			}
+			cursor.values = append(cursor.values, typed)
+		}
+		query.Cursor = &cursor
+	}
+
+	return query, nil
+}
+
+// Filter применяет фильтры к запросу. Сортировка применяется отдельно методом Order.
+func (q ListQuery) Filter(db *gorm.DB, spec ListQuerySpec) *gorm.DB {
+	if len(q.Statuses) > 0 {
+		db = db.Where("status IN ?", q.Statuses)
+	}
+	if q.CreatedFrom != nil {
+		db = db.Where("created_at >= ?", *q.CreatedFrom)
+	}
+	if q.CreatedTo != nil {
+		db = db.Where("created_at <= ?", *q.CreatedTo)
+	}
+	if len(q.OrganizationIDs) > 0 && spec.OrganizationColumn != "" {
+		db = db.Where(spec.OrganizationColumn+" IN ?", q.OrganizationIDs)
+	}
+	return db
+}
+
+// Order применяет сортировку к запросу. Идентификатор добавляется последним полем,
+// чтобы порядок записей с одинаковыми значениями был стабильным между страницами.
+func (q ListQuery) Order(db *gorm.DB) *gorm.DB {
+	for _, field := range q.Sort {
+		direction := " ASC"
+		if field.Desc {
+			direction = " DESC"
+		}
+		db = db.Order(field.Column + direction)
+	}
+	return db.Order("id ASC")
+}
+
+// After ограничивает выдачу записями, следующими за курсором в порядке сортировки.
+// Условие вида (a > x) OR (a = x AND b > y) OR ... раскрывается по всем полям сортировки и идентификатору.
+func (q ListQuery) After(db *gorm.DB) *gorm.DB {
+	if q.Cursor == nil {
+		return db
+	}
+
+	columns := make([]string, 0, len(q.Sort)+1)
+	operators := make([]string, 0, len(q.Sort)+1)
+	values := make([]interface{}, 0, len(q.Sort)+1)
+	for i, field := range q.Sort {
+		operator := ">"
+		if field.Desc {
+			operator = "<"
+		}
+		columns = append(columns, field.Column)
+		operators = append(operators, operator)
+		values = append(values, q.Cursor.values[i])
+	}
+	columns = append(columns, "id")
+	operators = append(operators, ">")
+	values = append(values, q.Cursor.ID)
+
+	var conditions []string
+	var args []interface{}
+	for i := range columns {
+		var parts []string
+		for j := 0; j < i; j++ {
+			parts = append(parts, columns[j]+" = ?")
+			args = append(args, values[j])
+		}
+		parts = append(parts, columns[i]+" "+operators[i]+" ?")
+		args = append(args, values[i])
+		conditions = append(conditions, "("+strings.Join(parts, " AND ")+")")
+	}
+
+	return db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
+
+// NextCursor формирует курсор, указывающий на запись с заданным идентификатором и значениями полей сортировки.
+// values содержит значения по имени столбца: name, created_at, version.
+func (q ListQuery) NextCursor(id uuid.UUID, values map[string]interface{}) string {
+	cursor := Cursor{Sort: q.sortKey(), ID: id}
+	for _, field := range q.Sort {
+		var value string
+		switch v := values[field.Column].(type) {
+		case time.Time:
+			value = v.UTC().Format(time.RFC3339Nano)
+		default:
+			value = fmt.Sprint(v)
+		}
+		cursor.Values = append(cursor.Values, value)
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// sortKey — каноническая запись сортировки, к которой привязан курсор.
+func (q ListQuery) sortKey() string {
+	parts := make([]string, 0, len(q.Sort))
+	for _, field := range q.Sort {
+		if field.Desc {
+			parts = append(parts, "-"+field.Column)
+		} else {
+			parts = append(parts, field.Column)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+func decodeCursor(value string) (Cursor, error) {
+	var cursor Cursor
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err
+}
+
+// cursorValue приводит значение из курсора к типу столбца.
+func cursorValue(column, value string) (interface{}, error) {
+	switch column {
+	case "created_at":
+		return time.Parse(time.RFC3339Nano, value)
+	case "version":
+		return strconv.Atoi(value)
+	default:
+		return value, nil
+	}
+}
+
+// splitValues раскладывает значения вида "a,b" и повторяющиеся параметры в один список.
+func splitValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
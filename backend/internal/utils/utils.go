@@ -4,50 +4,80 @@ import (
 	"errors"
 	"github.com/golang-jwt/jwt/v4"
 	"strconv"
+	"tender_management_api/internal/apierrors"
+	"tender_management_api/internal/i18n"
 	"time"
 
 	"github.com/gin-gonic/gin"
 )
 
-func GetPaginationParams(c *gin.Context) (limit int, offset int) {
+// GetPaginationParams разбирает параметры limit и offset. Значения вне допустимого диапазона считаются ошибкой.
+func GetPaginationParams(c *gin.Context) (limit int, offset int, err error) {
 	limitStr := c.DefaultQuery("limit", "5")
 	offsetStr := c.DefaultQuery("offset", "0")
 
-	limit, err := strconv.Atoi(limitStr)
+	limit, err = strconv.Atoi(limitStr)
 	if err != nil || limit < 0 || limit > 50 {
-		limit = 5
+		return 0, 0, apierrors.InvalidParameter(i18n.MsgInvalidLimit)
 	}
 
 	offset, err = strconv.Atoi(offsetStr)
 	if err != nil || offset < 0 {
-		offset = 0
+		return 0, 0, apierrors.InvalidParameter(i18n.MsgInvalidOffset)
 	}
 
-	return limit, offset
+	return limit, offset, nil
 }
 
-var jwtSecret = []byte("secret_key")
+// ParseTimeParam разбирает момент времени из параметра запроса в формате RFC3339 или YYYY-MM-DD.
+// Для даты без времени при endOfPeriod возвращается последний момент этого дня, чтобы граница включала весь день.
+func ParseTimeParam(value string, endOfPeriod bool) (time.Time, error) {
+	if moment, err := time.Parse(time.RFC3339, value); err == nil {
+		return moment, nil
+	}
+
+	day, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfPeriod {
+		return day.AddDate(0, 0, 1).Add(-time.Microsecond), nil
+	}
+	return day, nil
+}
 
-// ValidateToken проверяет валидность JWT токена.
-func ValidateToken(tokenString string) (bool, error) {
+// ParseToken проверяет JWT токен, подписанный секретом secret, и возвращает его утверждения.
+func ParseToken(tokenString string, secret []byte) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, errors.New("unexpected signing method")
 		}
-		return jwtSecret, nil
+		return secret, nil
 	})
 
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		exp := int64(claims["exp"].(float64))
-		if exp < time.Now().Unix() {
-			return false, errors.New("token expired")
+		exp, ok := claims["exp"].(float64)
+		if !ok {
+			return nil, errors.New("token has no expiration")
+		}
+		if int64(exp) < time.Now().Unix() {
+			return nil, errors.New("token expired")
 		}
-		return true, nil
+		return claims, nil
 	}
 
-	return false, errors.New("invalid token")
+	return nil, errors.New("invalid token")
+}
+
+// TokenSubject возвращает пользователя, которому выдан токен: утверждение username или sub.
+func TokenSubject(claims jwt.MapClaims) string {
+	if username, ok := claims["username"].(string); ok && username != "" {
+		return username
+	}
+	subject, _ := claims["sub"].(string)
+	return subject
 }
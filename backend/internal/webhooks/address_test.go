@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestForbiddenAddr(t *testing.T) {
+	forbidden := []string{
+		"127.0.0.1", "10.1.2.3", "172.20.0.1", "192.168.1.1", "169.254.169.254",
+		"100.64.0.1", "100.127.255.254", "0.1.2.3", "198.18.0.1", "198.19.255.255", "224.0.0.1", "255.255.255.255",
+		"::1", "::", "fe80::1", "fd00::1", "ff02::1",
+		"::ffff:127.0.0.1", "::ffff:10.0.0.1", "::ffff:100.64.0.1", "64:ff9b::a00:1", "2002:a00:1::1",
+	}
+	for _, raw := range forbidden {
+		if !forbiddenAddr(netip.MustParseAddr(raw)) {
+			t.Errorf("адрес %s должен быть запрещён", raw)
+		}
+	}
+
+	allowed := []string{"8.8.8.8", "100.128.0.1", "198.20.0.1", "::ffff:8.8.8.8", "2606:4700:4700::1111"}
+	for _, raw := range allowed {
+		if forbiddenAddr(netip.MustParseAddr(raw)) {
+			t.Errorf("адрес %s должен быть разрешён", raw)
+		}
+	}
+}
+
+func TestValidateURLLiteralAddresses(t *testing.T) {
+	for _, raw := range []string{"http://100.64.0.1/hook", "https://[::ffff:127.0.0.1]/hook", "http://0.0.0.0:8080/", "ftp://8.8.8.8/"} {
+		if err := ValidateURL(context.Background(), raw); !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s: ожидалась ErrForbiddenAddress, получено %v", raw, err)
+		}
+	}
+	if err := ValidateURL(context.Background(), "https://8.8.8.8/hook"); err != nil {
+		t.Errorf("публичный адрес отклонён: %v", err)
+	}
+}
+
+func TestDialerRejectsForbiddenAddresses(t *testing.T) {
+	dialer := newDialer(0)
+	for _, address := range []string{"127.0.0.1:80", "[::ffff:10.0.0.1]:443"} {
+		if _, err := dialer.Dial("tcp", address); !errors.Is(err, ErrForbiddenAddress) {
+			t.Errorf("%s: ожидалась ErrForbiddenAddress, получено %v", address, err)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package webhooks
+
+import (
+	"context"
+	"tender_management_api/internal/events"
+
+	"gorm.io/gorm"
+)
+
+// Sink ставит события из outbox в очередь доставки вебхуков.
+type Sink struct {
+	DB *gorm.DB
+}
+
+func (Sink) Name() string {
+	return "webhook"
+}
+
+func (s Sink) Publish(ctx context.Context, event events.Event) error {
+	return Publish(s.DB.WithContext(ctx), event)
+}
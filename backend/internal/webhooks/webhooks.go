@@ -0,0 +1,104 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"tender_management_api/internal/events"
+	"tender_management_api/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Заголовки запроса доставки.
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Sign вычисляет HMAC-SHA256 подпись полезной нагрузки.
+// Подписывается строка "<timestamp>.<тело запроса>", что защищает от повторной отправки старых запросов.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Subscribed проверяет, подписана ли подписка на тип события.
+func Subscribed(subscription models.WebhookSubscription, eventType events.Type) bool {
+	if subscription.Events == "" {
+		return true
+	}
+	for _, t := range strings.Split(subscription.Events, ",") {
+		if events.Type(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish ставит событие в очередь доставки для всех активных подписок затронутых организаций.
+// Вызов идемпотентен: повторная публикация того же события игнорируется.
+func Publish(db *gorm.DB, event events.Event) error {
+	if len(event.OrganizationIDs) == 0 {
+		return nil
+	}
+
+	var subscriptions []models.WebhookSubscription
+	if err := db.Where("organization_id IN ? AND active = ?", event.OrganizationIDs, true).Find(&subscriptions).Error; err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []models.WebhookDelivery
+	for _, subscription := range subscriptions {
+		if !Subscribed(subscription, event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, models.WebhookDelivery{
+			SubscriptionID: subscription.ID,
+			EventID:        event.ID,
+			EventType:      string(event.Type),
+			Payload:        models.JSONText(payload),
+			Status:         models.WebhookDeliveryPending,
+			NextAttemptAt:  time.Now(),
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	// Повторно опубликованное событие не создаёт дублей доставки
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&deliveries).Error
+}
+
+// Redeliver создаёт новую доставку с той же полезной нагрузкой. Исходная запись журнала не меняется.
+func Redeliver(db *gorm.DB, delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	originalID := delivery.ID
+	redelivery := models.WebhookDelivery{
+		ID:             uuid.New(),
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         models.WebhookDeliveryPending,
+		NextAttemptAt:  time.Now(),
+		RedeliveryOf:   &originalID,
+	}
+	err := db.Create(&redelivery).Error
+	return redelivery, err
+}
@@ -0,0 +1,197 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"tender_management_api/internal/health"
+	"tender_management_api/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// MaxAttempts — число попыток, после которого доставка считается неудачной.
+	MaxAttempts = 8
+	// BaseRetryDelay — задержка перед второй попыткой; каждая следующая задержка удваивается.
+	BaseRetryDelay = 10 * time.Second
+	// MaxRetryDelay ограничивает рост задержки между попытками.
+	MaxRetryDelay = time.Hour
+
+	pollInterval   = 2 * time.Second
+	batchSize      = 20
+	claimLease     = time.Minute
+	requestTimeout = 10 * time.Second
+)
+
+// RetryDelay возвращает задержку перед следующей попыткой после attempt неудачных попыток.
+func RetryDelay(attempt int) time.Duration {
+	delay := BaseRetryDelay
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= MaxRetryDelay {
+			return MaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// Worker отправляет ожидающие доставки и повторяет неудачные с экспоненциальной задержкой.
+type Worker struct {
+	db     *gorm.DB
+	client *http.Client
+	state  *health.State
+}
+
+func NewWorker(db *gorm.DB) *Worker {
+	// Прокси из окружения не используется: иначе dialer проверял бы адрес прокси, а не получателя
+	return &Worker{
+		db: db,
+		client: &http.Client{
+			Timeout:   requestTimeout,
+			Transport: &http.Transport{DialContext: newDialer(requestTimeout).DialContext},
+		},
+		state: health.Default,
+	}
+}
+
+// Run обрабатывает очередь доставок, пока не будет отменён контекст.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	// Каждый проход отмечается в состоянии готовности, чтобы зависший обработчик был заметен
+	w.state.Set(health.ComponentWebhooks, nil)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := w.processBatch(ctx)
+			if err != nil {
+				slog.ErrorContext(ctx, "Ошибка обработки очереди вебхуков", "error", err)
+			}
+			w.state.Set(health.ComponentWebhooks, err)
+		}
+	}
+}
+
+// claim забирает пачку доставок, срок которых наступил. Срок забранных записей сдвигается,
+// поэтому другие экземпляры сервиса не отправят их повторно, пока идёт попытка.
+func (w *Worker) claim() ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	err := w.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, time.Now()).
+			Order("next_attempt_at ASC").
+			Limit(batchSize).
+			Find(&deliveries).Error
+		if err != nil || len(deliveries) == 0 {
+			return err
+		}
+
+		ids := make([]interface{}, 0, len(deliveries))
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", time.Now().Add(claimLease)).Error
+	})
+	return deliveries, err
+}
+
+func (w *Worker) processBatch(ctx context.Context) error {
+	deliveries, err := w.claim()
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return nil
+		}
+		w.attempt(ctx, delivery)
+	}
+	return nil
+}
+
+// attempt выполняет одну попытку доставки и сохраняет её результат в журнал.
+func (w *Worker) attempt(ctx context.Context, delivery models.WebhookDelivery) {
+	var subscription models.WebhookSubscription
+	if err := w.db.Where("id = ?", delivery.SubscriptionID).First(&subscription).Error; err != nil {
+		slog.ErrorContext(ctx, "Подписка для доставки не найдена",
+			"subscription_id", delivery.SubscriptionID, "delivery_id", delivery.ID, "error", err)
+		return
+	}
+
+	updates := map[string]interface{}{"attempts": delivery.Attempts + 1}
+
+	if !subscription.Active {
+		updates["status"] = models.WebhookDeliveryFailed
+		updates["last_error"] = "Подписка отключена"
+	} else {
+		statusCode, sendErr := w.send(ctx, subscription, delivery)
+		updates["response_status"] = statusCode
+
+		switch {
+		case sendErr == nil:
+			now := time.Now()
+			updates["status"] = models.WebhookDeliveryDelivered
+			updates["delivered_at"] = &now
+			updates["last_error"] = ""
+		case delivery.Attempts+1 >= MaxAttempts:
+			updates["status"] = models.WebhookDeliveryFailed
+			updates["last_error"] = truncate(sendErr.Error(), 1000)
+		default:
+			updates["next_attempt_at"] = time.Now().Add(RetryDelay(delivery.Attempts + 1))
+			updates["last_error"] = truncate(sendErr.Error(), 1000)
+		}
+	}
+
+	if err := w.db.Model(&models.WebhookDelivery{}).Where("id = ?", delivery.ID).Updates(updates).Error; err != nil {
+		slog.ErrorContext(ctx, "Не удалось сохранить результат доставки", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+// send отправляет подписанный запрос подписчику. Успехом считается любой ответ 2xx.
+func (w *Worker) send(ctx context.Context, subscription models.WebhookSubscription, delivery models.WebhookDelivery) (int, error) {
+	payload := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(HeaderEvent, delivery.EventType)
+	request.Header.Set(HeaderDelivery, delivery.ID.String())
+	request.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, payload))
+
+	response, err := w.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("подписчик ответил статусом %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+func truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+	return string(runes[:max])
+}
@@ -0,0 +1,2 @@
+-- Пропущенные события потока тендера читаются в порядке публикации.
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_published ON outbox_messages (tender_id, published_at, sequence);
@@ -0,0 +1,7 @@
+-- Отметки об отправленных письмах: повторная публикация события не отправляет письмо повторно.
+CREATE TABLE IF NOT EXISTS email_deliveries (
+    user_id UUID NOT NULL,
+    dedup_key VARCHAR(200) NOT NULL,
+    sent_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (dedup_key, user_id)
+);
@@ -0,0 +1,25 @@
+-- Удаляются только таблицы и тип, созданные миграцией. Таблицы, которые уже были в базе,
+-- остаются нетронутыми; то же относится к базам, где миграция применялась до появления отметок.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'table' AND name = {{literal .OrganizationResponsible}}) THEN
+        DROP TABLE {{.OrganizationResponsible}};
+    END IF;
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'table' AND name = {{literal .Organization}}) THEN
+        DROP TABLE {{.Organization}};
+    END IF;
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'table' AND name = {{literal .Employee}}) THEN
+        DROP TABLE {{.Employee}};
+    END IF;
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'type' AND name = {{literal .OrganizationType}}) THEN
+        DROP TYPE {{.OrganizationType}};
+    END IF;
+    DELETE FROM schema_owned_objects
+    WHERE (kind, name) IN (
+        ('table', {{literal .OrganizationResponsible}}),
+        ('table', {{literal .Organization}}),
+        ('table', {{literal .Employee}}),
+        ('type', {{literal .OrganizationType}})
+    );
+END
+$$;
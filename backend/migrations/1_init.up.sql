@@ -0,0 +1,51 @@
+-- Сотрудники и организации в том виде, в котором они описаны в задании.
+-- В рабочей базе эти таблицы уже могут существовать, поэтому они создаются только при отсутствии.
+-- Имена таблиц и схема подставляются из конфигурации (см. internal/database/migration_template.go).
+-- Созданные здесь объекты отмечаются в schema_owned_objects: откат удаляет только их.
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+DO $$
+BEGIN
+    IF to_regtype({{literal .OrganizationType}}) IS NULL THEN
+        CREATE TYPE {{.OrganizationType}} AS ENUM (
+            'IE',
+            'LLC',
+            'JSC'
+        );
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('type', {{literal .OrganizationType}});
+    END IF;
+
+    IF to_regclass({{literal .Employee}}) IS NULL THEN
+        CREATE TABLE {{.Employee}} (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            username VARCHAR(50) UNIQUE NOT NULL,
+            first_name VARCHAR(50),
+            last_name VARCHAR(50),
+            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+        );
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('table', {{literal .Employee}});
+    END IF;
+
+    IF to_regclass({{literal .Organization}}) IS NULL THEN
+        CREATE TABLE {{.Organization}} (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            name VARCHAR(100) NOT NULL,
+            description TEXT,
+            type {{.OrganizationType}},
+            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+            updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+        );
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('table', {{literal .Organization}});
+    END IF;
+
+    IF to_regclass({{literal .OrganizationResponsible}}) IS NULL THEN
+        CREATE TABLE {{.OrganizationResponsible}} (
+            id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+            organization_id UUID REFERENCES {{.Organization}}(id) ON DELETE CASCADE,
+            user_id UUID REFERENCES {{.Employee}}(id) ON DELETE CASCADE
+        );
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('table', {{literal .OrganizationResponsible}});
+    END IF;
+END
+$$;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS bid_feedbacks;
+DROP TABLE IF EXISTS bid_versions;
+DROP TABLE IF EXISTS bids;
+DROP TABLE IF EXISTS tender_versions;
+DROP TABLE IF EXISTS tenders;
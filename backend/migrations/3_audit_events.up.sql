@@ -0,0 +1,23 @@
+-- Журнал аудита. Записи образуют хеш-цепочку в пределах тендера.
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor_id UUID NOT NULL,
+    actor_username VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    tender_id UUID NOT NULL,
+    sequence BIGINT,
+    organization_id UUID NOT NULL,
+    action VARCHAR(30) NOT NULL,
+    before JSONB,
+    after JSONB,
+    request_id VARCHAR(100),
+    prev_hash VARCHAR(64),
+    hash VARCHAR(64),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_entity ON audit_events (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_chain ON audit_events (tender_id, sequence);
+CREATE INDEX IF NOT EXISTS idx_audit_events_organization_id ON audit_events (organization_id);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events (created_at);
@@ -0,0 +1,56 @@
+-- Outbox событий и доставка вебхуков.
+CREATE TABLE IF NOT EXISTS outbox_messages (
+    sequence BIGSERIAL PRIMARY KEY,
+    dedup_key VARCHAR(200) NOT NULL,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    tender_id UUID NOT NULL,
+    bid_id UUID,
+    organization_ids VARCHAR(1000),
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts BIGINT DEFAULT 0,
+    last_error VARCHAR(1000),
+    available_at TIMESTAMPTZ,
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_outbox_messages_dedup_key ON outbox_messages (dedup_key);
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_tender_id ON outbox_messages (tender_id);
+CREATE INDEX IF NOT EXISTS idx_outbox_messages_due ON outbox_messages (status, available_at);
+
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL,
+    url VARCHAR(500) NOT NULL,
+    secret VARCHAR(256) NOT NULL,
+    events VARCHAR(1000),
+    active BOOLEAN DEFAULT TRUE,
+    created_by UUID,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_organization_id ON webhook_subscriptions (organization_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    subscription_id UUID NOT NULL,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts BIGINT DEFAULT 0,
+    response_status BIGINT DEFAULT 0,
+    last_error VARCHAR(1000),
+    next_attempt_at TIMESTAMPTZ,
+    delivered_at TIMESTAMPTZ,
+    redelivery_of UUID,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_webhook_deliveries_event ON webhook_deliveries (subscription_id, event_id) WHERE redelivery_of IS NULL;
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS notification_preferences;
+
+-- Удаляются только столбцы, добавленные миграцией, а не существовавшие в таблице сотрудников раньше.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'column' AND name = {{literal (print .Employee ".language")}}) THEN
+        ALTER TABLE {{.Employee}} DROP COLUMN IF EXISTS language;
+    END IF;
+    IF EXISTS (SELECT 1 FROM schema_owned_objects WHERE kind = 'column' AND name = {{literal (print .Employee ".email")}}) THEN
+        ALTER TABLE {{.Employee}} DROP COLUMN IF EXISTS email;
+    END IF;
+    DELETE FROM schema_owned_objects
+    WHERE kind = 'column' AND name IN ({{literal (print .Employee ".language")}}, {{literal (print .Employee ".email")}});
+END
+$$;
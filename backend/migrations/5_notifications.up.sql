@@ -0,0 +1,40 @@
+-- Уведомления: контакты сотрудников, настройки и внутренний почтовый ящик.
+-- Столбцы добавляются в таблицу сотрудников из конфигурации, только если их нет,
+-- и отмечаются в schema_owned_objects: откат удаляет только их.
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_attribute WHERE attrelid = to_regclass({{literal .Employee}}) AND attname = 'email' AND NOT attisdropped) THEN
+        ALTER TABLE {{.Employee}} ADD COLUMN email VARCHAR(255);
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('column', {{literal (print .Employee ".email")}});
+    END IF;
+    IF NOT EXISTS (SELECT 1 FROM pg_attribute WHERE attrelid = to_regclass({{literal .Employee}}) AND attname = 'language' AND NOT attisdropped) THEN
+        ALTER TABLE {{.Employee}} ADD COLUMN language VARCHAR(2) DEFAULT 'ru';
+        INSERT INTO schema_owned_objects (kind, name) VALUES ('column', {{literal (print .Employee ".language")}});
+    END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    email_enabled BOOLEAN NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notification_preferences_user_event ON notification_preferences (user_id, event_type);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL,
+    dedup_key VARCHAR(200) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    tender_id UUID NOT NULL,
+    bid_id UUID,
+    title VARCHAR(300) NOT NULL,
+    body TEXT,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_user_event ON notifications (user_id, dedup_key);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_tenders_search_vector;
+ALTER TABLE tenders DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,9 @@
+-- Полнотекстовый поиск по тендерам: вычисляемый tsvector с русской конфигурацией и GIN-индекс по нему.
+-- Совпадения в названии весят больше, чем в описании.
+ALTER TABLE tenders ADD COLUMN IF NOT EXISTS search_vector tsvector
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(description, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tenders_search_vector ON tenders USING GIN (search_vector);
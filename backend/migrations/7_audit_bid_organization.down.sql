@@ -0,0 +1,2 @@
+-- Откат завершится ошибкой, если уже есть события без организации: журнал не изменяется задним числом.
+ALTER TABLE audit_events ALTER COLUMN organization_id SET NOT NULL;
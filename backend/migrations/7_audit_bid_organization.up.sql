@@ -0,0 +1,3 @@
+-- События предложений от имени пользователя не относятся ни к одной организации.
+-- Уже записанные события не исправляются: их содержимое входит в хеш-цепочку.
+ALTER TABLE audit_events ALTER COLUMN organization_id DROP NOT NULL;
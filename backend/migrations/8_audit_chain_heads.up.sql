@@ -0,0 +1,14 @@
+-- Вершина хеш-цепочки журнала аудита для каждого тендера.
+CREATE TABLE IF NOT EXISTS audit_chain_heads (
+    tender_id UUID PRIMARY KEY,
+    sequence BIGINT NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Вершины уже существующих цепочек берутся из последних записей
+INSERT INTO audit_chain_heads (tender_id, sequence, hash, updated_at)
+SELECT DISTINCT ON (tender_id) tender_id, sequence, hash, created_at
+FROM audit_events
+ORDER BY tender_id, sequence DESC
+ON CONFLICT (tender_id) DO NOTHING;
@@ -0,0 +1,7 @@
+-- Отметки о передаче сообщений outbox каждому получателю.
+CREATE TABLE IF NOT EXISTS outbox_deliveries (
+    message_sequence BIGINT NOT NULL REFERENCES outbox_messages (sequence) ON DELETE CASCADE,
+    sink VARCHAR(50) NOT NULL,
+    delivered_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (message_sequence, sink)
+);
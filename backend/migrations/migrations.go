@@ -0,0 +1,8 @@
+// Package migrations содержит SQL-миграции схемы базы данных.
+// Файлы называются <версия>_<название>.up.sql и <версия>_<название>.down.sql.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS